@@ -0,0 +1,292 @@
+package galatvtr
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const defaultBarkServer = "https://api.day.app"
+
+// BarkLevel Bark 通知的中断级别
+type BarkLevel string
+
+const (
+	BarkActive        BarkLevel = "active"        // 默认，立即亮屏显示通知
+	BarkTimeSensitive BarkLevel = "timeSensitive" // 时效性通知，可在专注模式下显示
+	BarkPassive       BarkLevel = "passive"       // 仅添加到通知列表，不亮屏提醒
+	BarkCritical      BarkLevel = "critical"      // 重要警告，在静音和专注模式下也会响铃
+)
+
+// BarkError Bark 服务返回的错误
+type BarkError struct {
+	StatusCode int    // HTTP 状态码
+	Code       int    // 响应中的 code，成功为 200
+	Message    string // 响应中的 message
+}
+
+func (e *BarkError) Error() string {
+	return fmt.Sprintf("Bark推送失败: HTTP %d, code %d, %s", e.StatusCode, e.Code, e.Message)
+}
+
+// BarkPush 一条 Bark 推送，空字段不发送，使用 App 中的默认设置
+type BarkPush struct {
+	Title    string    // 标题
+	Subtitle string    // 副标题
+	Body     string    // 正文
+	Level    BarkLevel // 中断级别
+	Volume   int       // 重要警告的音量 0-10，仅 critical 有效
+	Badge    int       // App 角标数字
+	Call     bool      // 是否重复播放铃声
+	Sound    string    // 铃声名称
+	Icon     string    // 自定义图标地址
+	Group    string    // 消息分组
+	URL      string    // 点击通知跳转的地址
+	Copy     string    // 复制通知时复制的内容，为空时复制整条通知
+	AutoCopy bool      // 是否自动复制
+	Archive  *bool     // 是否保存到历史记录，为 nil 时使用 App 中的设置
+}
+
+func (p BarkPush) payload() map[string]interface{} {
+	payload := map[string]interface{}{"body": p.Body}
+	set := func(key, value string) {
+		if value != "" {
+			payload[key] = value
+		}
+	}
+	set("title", p.Title)
+	set("subtitle", p.Subtitle)
+	set("level", string(p.Level))
+	set("sound", p.Sound)
+	set("icon", p.Icon)
+	set("group", p.Group)
+	set("url", p.URL)
+	set("copy", p.Copy)
+	if p.Level == BarkCritical && p.Volume > 0 {
+		payload["volume"] = p.Volume
+	}
+	if p.Badge > 0 {
+		payload["badge"] = p.Badge
+	}
+	if p.Call {
+		payload["call"] = "1"
+	}
+	if p.AutoCopy {
+		payload["autoCopy"] = "1"
+	}
+	if p.Archive != nil {
+		payload["isArchive"] = "0"
+		if *p.Archive {
+			payload["isArchive"] = "1"
+		}
+	}
+	return payload
+}
+
+// BarkClient Bark 推送客户端，EncryptKey 不为空时使用 AES 加密推送内容
+type BarkClient struct {
+	ServerURL string       // Bark 服务地址，默认官方服务，自建服务填写对应地址
+	DeviceKey string       // 设备 key
+	Client    *http.Client // 为空时使用带超时的默认客户端
+
+	EncryptKey  string // AES 密钥，长度 16/24/32 对应 AES-128/192/256，需与 App 中的设置一致
+	EncryptIV   string // 初始向量，CBC 为 16 位、GCM 为 12 位，为空时每次随机生成并随请求发送
+	EncryptMode string // 加密模式 CBC/ECB/GCM，默认 CBC，填充方式为 PKCS7
+}
+
+// Push 发送推送，网络错误原样返回，服务端拒绝时返回 *BarkError
+func (c *BarkClient) Push(ctx context.Context, push BarkPush) error {
+	if c.DeviceKey == "" {
+		return fmt.Errorf("Bark 设备 key 不能为空")
+	}
+	server := strings.TrimSuffix(c.ServerURL, "/")
+	if server == "" {
+		server = defaultBarkServer
+	}
+
+	var req *http.Request
+	if c.EncryptKey == "" {
+		payload := push.payload()
+		payload["device_key"] = c.DeviceKey
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, server+"/push", bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	} else {
+		data, err := json.Marshal(push.payload())
+		if err != nil {
+			return err
+		}
+		ciphertext, iv, err := c.encrypt(data)
+		if err != nil {
+			return err
+		}
+		form := url.Values{"ciphertext": {ciphertext}}
+		if iv != "" {
+			form.Set("iv", iv)
+		}
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, server+"/"+url.PathEscape(c.DeviceKey), strings.NewReader(form.Encode()))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	client := c.Client
+	if client == nil {
+		client = defaultNotifyClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("Bark推送请求失败: %w", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+
+	var result struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return &BarkError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(body))}
+	}
+	if resp.StatusCode != http.StatusOK || result.Code != http.StatusOK {
+		return &BarkError{StatusCode: resp.StatusCode, Code: result.Code, Message: result.Message}
+	}
+	return nil
+}
+
+// encrypt 按 Bark App 的加密设置加密推送内容，返回 base64 密文和需要随请求发送的 iv
+func (c *BarkClient) encrypt(plaintext []byte) (string, string, error) {
+	block, err := aes.NewCipher([]byte(c.EncryptKey))
+	if err != nil {
+		return "", "", fmt.Errorf("Bark 加密密钥长度必须为 16、24 或 32: %v", err)
+	}
+
+	mode := strings.ToUpper(c.EncryptMode)
+	iv := c.EncryptIV
+	switch mode {
+	case "", "CBC":
+		if iv == "" {
+			if iv, err = randomBarkIV(aes.BlockSize); err != nil {
+				return "", "", err
+			}
+		}
+		if len(iv) != aes.BlockSize {
+			return "", "", fmt.Errorf("Bark CBC 模式的 iv 长度必须为 %d", aes.BlockSize)
+		}
+		data := pkcs7Pad(plaintext, aes.BlockSize)
+		cipher.NewCBCEncrypter(block, []byte(iv)).CryptBlocks(data, data)
+		return base64.StdEncoding.EncodeToString(data), iv, nil
+	case "ECB":
+		data := pkcs7Pad(plaintext, aes.BlockSize)
+		for i := 0; i < len(data); i += aes.BlockSize {
+			block.Encrypt(data[i:i+aes.BlockSize], data[i:i+aes.BlockSize])
+		}
+		return base64.StdEncoding.EncodeToString(data), "", nil
+	case "GCM":
+		gcm, err := cipher.NewGCM(block)
+		if err != nil {
+			return "", "", err
+		}
+		if iv == "" {
+			if iv, err = randomBarkIV(gcm.NonceSize()); err != nil {
+				return "", "", err
+			}
+		}
+		if len(iv) != gcm.NonceSize() {
+			return "", "", fmt.Errorf("Bark GCM 模式的 iv 长度必须为 %d", gcm.NonceSize())
+		}
+		// 密文后附带认证标签
+		return base64.StdEncoding.EncodeToString(gcm.Seal(nil, []byte(iv), plaintext, nil)), iv, nil
+	default:
+		return "", "", fmt.Errorf("不支持的 Bark 加密模式: %s", c.EncryptMode)
+	}
+}
+
+// pkcs7Pad 按 PKCS7 填充到 blockSize 的整数倍
+func pkcs7Pad(data []byte, blockSize int) []byte {
+	padding := blockSize - len(data)%blockSize
+	return append(append([]byte(nil), data...), bytes.Repeat([]byte{byte(padding)}, padding)...)
+}
+
+// randomBarkIV 生成随机的字母数字 iv，Bark 以字符串形式接收 iv
+func randomBarkIV(n int) (string, error) {
+	const letters = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+	iv := make([]byte, n)
+	for i := range iv {
+		index, err := rand.Int(rand.Reader, big.NewInt(int64(len(letters))))
+		if err != nil {
+			return "", err
+		}
+		iv[i] = letters[index.Int64()]
+	}
+	return string(iv), nil
+}
+
+// BarkNotifier Bark 推送，消息的第一个标签作为分组，warning 及以上级别使用时效性通知
+type BarkNotifier struct {
+	ServerURL       string `json:"serverUrl,omitempty"`       // Bark 服务地址，默认官方服务
+	Token           string `json:"token"`                     // 设备 key
+	Sound           string `json:"sound,omitempty"`           // 铃声，为空时成功消息使用 alarm，其它使用 anticipate
+	Icon            string `json:"icon,omitempty"`            // 自定义图标地址
+	Group           string `json:"group,omitempty"`           // 消息分组，为空时使用消息的第一个标签
+	Archive         *bool  `json:"archive,omitempty"`         // 是否保存到历史记录，为空时使用 App 中的设置
+	CriticalOnError bool   `json:"criticalOnError,omitempty"` // error 级别是否使用重要警告（静音时也响铃）
+	EncryptKey      string `json:"encryptKey,omitempty"`      // AES 密钥，为空时不加密
+	EncryptIV       string `json:"encryptIv,omitempty"`       // AES 初始向量
+	EncryptMode     string `json:"encryptMode,omitempty"`     // 加密模式 CBC/ECB/GCM
+
+	Client *http.Client `json:"-"` // 为空时使用默认客户端
+}
+
+func (n *BarkNotifier) Send(ctx context.Context, msg Message) error {
+	client := &BarkClient{
+		ServerURL:   n.ServerURL,
+		DeviceKey:   n.Token,
+		Client:      n.Client,
+		EncryptKey:  n.EncryptKey,
+		EncryptIV:   n.EncryptIV,
+		EncryptMode: n.EncryptMode,
+	}
+	push := BarkPush{
+		Title:   msg.Title,
+		Body:    msg.Body,
+		Sound:   n.Sound,
+		Icon:    n.Icon,
+		Group:   n.Group,
+		Archive: n.Archive,
+	}
+	if push.Sound == "" {
+		// 与 PushMsgBark 一致，成功消息使用 alarm 铃声
+		push.Sound = "anticipate"
+		if msg.Level == NotifySuccess {
+			push.Sound = "alarm"
+		}
+	}
+	if push.Group == "" && len(msg.Tags) > 0 {
+		push.Group = msg.Tags[0]
+	}
+	switch {
+	case msg.Level == NotifyError && n.CriticalOnError:
+		push.Level = BarkCritical
+	case msg.Level.rank() >= NotifyWarning.rank():
+		push.Level = BarkTimeSensitive
+	}
+	return client.Push(ctx, push)
+}
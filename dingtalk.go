@@ -0,0 +1,190 @@
+package galatvtr
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const dingTalkRobotURL = "https://oapi.dingtalk.com/robot/send"
+
+// DingTalkError 钉钉机器人返回的业务错误，HTTP 状态码为 200 时也可能出现，如签名错误、关键词不匹配、发送过于频繁
+type DingTalkError struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
+
+func (e *DingTalkError) Error() string {
+	return fmt.Sprintf("钉钉推送失败: %d %s", e.ErrCode, e.ErrMsg)
+}
+
+// DingTalkAt @ 提醒设置
+type DingTalkAt struct {
+	AtMobiles []string `json:"atMobiles,omitempty"` // 被 @ 人的手机号
+	AtUserIds []string `json:"atUserIds,omitempty"` // 被 @ 人的用户ID
+	IsAtAll   bool     `json:"isAtAll,omitempty"`   // 是否 @ 所有人
+}
+
+// mentions 生成正文中的 @ 文本，钉钉要求被 @ 的手机号或用户ID出现在正文中才会高亮
+func (a *DingTalkAt) mentions() string {
+	if a == nil {
+		return ""
+	}
+	var parts []string
+	for _, mobile := range a.AtMobiles {
+		parts = append(parts, "@"+mobile)
+	}
+	for _, userId := range a.AtUserIds {
+		parts = append(parts, "@"+userId)
+	}
+	return strings.Join(parts, " ")
+}
+
+// DingTalkButton actionCard 的按钮
+type DingTalkButton struct {
+	Title     string `json:"title"`     // 按钮标题
+	ActionURL string `json:"actionURL"` // 点击按钮跳转的地址
+}
+
+// DingTalkActionCard actionCard 消息，设置 SingleTitle 和 SingleURL 时为整体跳转，否则使用 Btns 独立跳转
+type DingTalkActionCard struct {
+	Title          string           `json:"title"`                    // 会话列表中展示的标题
+	Text           string           `json:"text"`                     // markdown 格式的正文
+	BtnOrientation string           `json:"btnOrientation,omitempty"` // 0：按钮竖直排列 1：按钮横向排列
+	SingleTitle    string           `json:"singleTitle,omitempty"`    // 单个按钮的标题
+	SingleURL      string           `json:"singleURL,omitempty"`      // 单个按钮的跳转地址
+	Btns           []DingTalkButton `json:"btns,omitempty"`           // 独立跳转的按钮
+}
+
+// DingTalkRobot 钉钉自定义机器人，Secret 不为空时使用加签方式调用
+type DingTalkRobot struct {
+	Token  string       // 机器人 access_token
+	Secret string       // 加签密钥，以 SEC 开头
+	Client *http.Client // 为空时使用默认客户端
+}
+
+// SendText 发送文本消息
+func (r *DingTalkRobot) SendText(ctx context.Context, content string, at *DingTalkAt) error {
+	if mentions := at.mentions(); mentions != "" {
+		content += "\n" + mentions
+	}
+	payload := map[string]interface{}{
+		"msgtype": "text",
+		"text":    map[string]string{"content": content},
+	}
+	if at != nil {
+		payload["at"] = at
+	}
+	return r.send(ctx, payload)
+}
+
+// SendMarkdown 发送 markdown 消息，title 用于会话列表展示
+func (r *DingTalkRobot) SendMarkdown(ctx context.Context, title, text string, at *DingTalkAt) error {
+	if mentions := at.mentions(); mentions != "" {
+		text += "\n\n" + mentions
+	}
+	payload := map[string]interface{}{
+		"msgtype":  "markdown",
+		"markdown": map[string]string{"title": title, "text": text},
+	}
+	if at != nil {
+		payload["at"] = at
+	}
+	return r.send(ctx, payload)
+}
+
+// SendActionCard 发送 actionCard 消息，适合带跳转链接的交易报告
+func (r *DingTalkRobot) SendActionCard(ctx context.Context, card DingTalkActionCard) error {
+	if card.SingleURL == "" && len(card.Btns) == 0 {
+		return fmt.Errorf("actionCard 需要 singleURL 或 btns")
+	}
+	return r.send(ctx, map[string]interface{}{
+		"msgtype":    "actionCard",
+		"actionCard": card,
+	})
+}
+
+// send 发送消息并检查 errcode
+func (r *DingTalkRobot) send(ctx context.Context, payload interface{}) error {
+	body, err := postJSON(ctx, r.Client, r.webhookURL(time.Now()), payload, nil)
+	if err != nil {
+		return err
+	}
+	var result DingTalkError
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("解析钉钉响应失败: %v, %s", err, string(body))
+	}
+	if result.ErrCode != 0 {
+		return &result
+	}
+	return nil
+}
+
+// webhookURL 生成请求地址，加签时以 timestamp + "\n" + secret 为内容、secret 为密钥做 HmacSHA256
+func (r *DingTalkRobot) webhookURL(now time.Time) string {
+	query := url.Values{"access_token": {r.Token}}
+	if r.Secret != "" {
+		timestamp := strconv.FormatInt(now.UnixMilli(), 10)
+		mac := hmac.New(sha256.New, []byte(r.Secret))
+		mac.Write([]byte(timestamp + "\n" + r.Secret))
+		query.Set("timestamp", timestamp)
+		query.Set("sign", base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	}
+	return dingTalkRobotURL + "?" + query.Encode()
+}
+
+// DingTalkNotifier 钉钉群机器人推送，级别不低于 AtLevel 的消息会 @ 指定的人
+type DingTalkNotifier struct {
+	Token     string      `json:"token"`               // 机器人 access_token
+	Secret    string      `json:"secret,omitempty"`    // 加签密钥，未开启加签时为空
+	Name      string      `json:"name,omitempty"`      // 消息前缀名称，可作为机器人的自定义关键词
+	Markdown  bool        `json:"markdown,omitempty"`  // 是否以 markdown 格式发送
+	AtMobiles []string    `json:"atMobiles,omitempty"` // 需要 @ 的手机号
+	AtUserIds []string    `json:"atUserIds,omitempty"` // 需要 @ 的用户ID
+	AtAll     bool        `json:"atAll,omitempty"`     // 是否 @ 所有人
+	AtLevel   NotifyLevel `json:"atLevel,omitempty"`   // 触发 @ 的最低级别，默认 error
+
+	Client *http.Client `json:"-"` // 为空时使用默认客户端
+}
+
+func (n *DingTalkNotifier) Send(ctx context.Context, msg Message) error {
+	robot := &DingTalkRobot{Token: n.Token, Secret: n.Secret, Client: n.Client}
+
+	atLevel := n.AtLevel
+	if atLevel == "" {
+		atLevel = NotifyError
+	}
+	var at *DingTalkAt
+	if msg.Level.rank() >= atLevel.rank() && (len(n.AtMobiles) > 0 || len(n.AtUserIds) > 0 || n.AtAll) {
+		at = &DingTalkAt{AtMobiles: n.AtMobiles, AtUserIds: n.AtUserIds, IsAtAll: n.AtAll}
+	}
+
+	prefix := ""
+	if n.Name != "" {
+		prefix = fmt.Sprintf("【%s通知】", n.Name)
+	}
+	if !n.Markdown {
+		return robot.SendText(ctx, prefix+msg.Text(), at)
+	}
+
+	title := prefix + msg.Title
+	var text strings.Builder
+	text.WriteString("### " + title + "\n\n")
+	if msg.Level != "" && msg.Level != NotifyInfo {
+		text.WriteString("**" + strings.ToUpper(string(msg.Level)) + "**\n\n")
+	}
+	// markdown 中单个换行不会换行，需要两个空格或空行
+	text.WriteString(strings.ReplaceAll(msg.Body, "\n", "  \n"))
+	if len(msg.Tags) > 0 {
+		text.WriteString("\n\n> #" + strings.Join(msg.Tags, " #"))
+	}
+	return robot.SendMarkdown(ctx, title, text.String(), at)
+}
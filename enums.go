@@ -20,3 +20,29 @@ func (e Exchange) DisplayName() string {
 		return string(e)
 	}
 }
+
+// OKX 账户类型，资金账户和交易账户的取值与资金划转接口的 from/to 一致
+type OkxAccount string
+
+const (
+	OkxAccountFunding    OkxAccount = "6"    // 资金账户
+	OkxAccountTrading    OkxAccount = "18"   // 交易账户
+	OkxAccountEarn       OkxAccount = "earn" // 稳定赚币，需通过申购/赎回进出，不能直接划转
+	OkxAccountSubAccount OkxAccount = "sub"  // 子账户，划转时需配合子账户名称
+)
+
+// 获取账户显示名称
+func (a OkxAccount) DisplayName() string {
+	switch a {
+	case OkxAccountFunding:
+		return "资金账户"
+	case OkxAccountTrading:
+		return "交易账户"
+	case OkxAccountEarn:
+		return "稳定赚币"
+	case OkxAccountSubAccount:
+		return "子账户"
+	default:
+		return string(a)
+	}
+}
@@ -0,0 +1,365 @@
+package galatvtr
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ExecAlgo 拆单执行算法
+type ExecAlgo string
+
+const (
+	ExecTwap    ExecAlgo = "twap"    // 在 Duration 内按时间均匀拆成 Slices 笔
+	ExecIceberg ExecAlgo = "iceberg" // 每次只挂出 VisibleSz，成交后再挂下一笔
+	ExecPov     ExecAlgo = "pov"     // 按市场成交量的 Participation 比例跟随下单
+)
+
+const (
+	defaultExecInterval = 5 * time.Second
+	defaultExecPoll     = time.Second
+	// 撤单后等待交易所确认订单结束的最多查询次数
+	defaultExecCancelPolls = 10
+)
+
+// ExecInstrument 下单数量和价格规则
+type ExecInstrument struct {
+	LotSz  float64 // 下单数量精度
+	MinSz  float64 // 最小下单数量
+	TickSz float64 // 下单价格精度
+	CtVal  float64 // 每单位数量对应的交易货币数量，现货为 1，合约为合约面值
+}
+
+// ExecChildOrder 子订单
+type ExecChildOrder struct {
+	InstId string
+	Side   string  // buy/sell
+	Sz     float64 // 数量，现货为交易货币数量，合约为张数
+	Px     float64 // 限价，0 表示市价
+}
+
+// ExecChildStatus 子订单状态
+type ExecChildStatus struct {
+	Filled float64 // 累计成交数量
+	AvgPx  float64 // 成交均价
+	Done   bool    // 是否已结束（完全成交或已撤销）
+}
+
+// ExecVenue 拆单执行所需的交易所操作
+type ExecVenue interface {
+	// Instrument 获取下单数量和价格规则
+	Instrument(instId string) (ExecInstrument, error)
+	// LastPrice 获取最新成交价
+	LastPrice(instId string) (float64, error)
+	// TradedVolume 获取 sinceMs 之后的市场成交量
+	TradedVolume(instId string, sinceMs int64) (float64, error)
+	// PlaceChild 下子订单，返回订单ID
+	PlaceChild(order ExecChildOrder) (string, error)
+	// ChildStatus 查询子订单状态
+	ChildStatus(instId, orderId string) (ExecChildStatus, error)
+	// CancelChild 撤销子订单
+	CancelChild(instId, orderId string) error
+}
+
+// ExecRequest 母单
+type ExecRequest struct {
+	InstId string   // 产品ID
+	Side   string   // buy/sell
+	Sz     float64  // 母单总数量
+	Algo   ExecAlgo // 执行算法
+
+	Duration      time.Duration // TWAP 的执行时长；冰山和 POV 的最长执行时间，0 表示不限制
+	Slices        int           // TWAP 拆分笔数，默认 Duration/Interval
+	Interval      time.Duration // TWAP 子订单间隔，POV 统计成交量的间隔，默认 5 秒
+	VisibleSz     float64       // 冰山每次挂出的数量
+	Participation float64       // POV 参与率，0.1 表示市场成交量的 10%
+	LimitPx       float64       // 子订单限价，0 表示市价，冰山必填
+	ChildTimeout  time.Duration // 限价子订单未完全成交时的最长等待时间，超时撤单，默认 Interval；冰山默认一直等待
+}
+
+// ExecFill 子订单执行结果
+type ExecFill struct {
+	OrderId string
+	Sz      float64 // 子订单数量
+	Filled  float64 // 成交数量
+	AvgPx   float64 // 成交均价
+	Time    time.Time
+}
+
+// ExecReport 执行报告
+type ExecReport struct {
+	InstId      string
+	Side        string
+	Algo        ExecAlgo
+	Requested   float64    // 母单数量
+	Filled      float64    // 累计成交数量
+	AvgPx       float64    // 成交均价
+	ArrivalPx   float64    // 开始执行时的最新价格
+	SlippageBps float64    // 成交均价相对到达价格的滑点（基点），正数表示不利
+	Children    []ExecFill // 子订单
+	Residual    float64    // 未成交的剩余数量
+	Canceled    bool       // 是否因 ctx 结束或超过执行时间而中止，此时 Residual 不低于最小下单数量
+	Elapsed     time.Duration
+}
+
+func (r *ExecReport) addFill(fill ExecFill) {
+	r.Children = append(r.Children, fill)
+	if fill.Filled <= 0 {
+		return
+	}
+	r.AvgPx = (r.AvgPx*r.Filled + fill.AvgPx*fill.Filled) / (r.Filled + fill.Filled)
+	r.Filled += fill.Filled
+}
+
+func (r *ExecReport) finish(start time.Time) {
+	r.Elapsed = time.Since(start)
+	if r.Filled > 0 && r.ArrivalPx > 0 {
+		r.SlippageBps = (r.AvgPx - r.ArrivalPx) / r.ArrivalPx * 1e4
+		if r.Side == "sell" {
+			r.SlippageBps = -r.SlippageBps
+		}
+	}
+	fmt.Printf("[Exec] %s %s %s 完成，成交 %v/%v，均价 %v，到达价格 %v，滑点 %.2f bps，耗时 %s\n",
+		r.Algo, r.Side, r.InstId, r.Filled, r.Requested, r.AvgPx, r.ArrivalPx, r.SlippageBps, r.Elapsed)
+}
+
+// Execute 按 req.Algo 将母单拆成子订单在 venue 上执行。子订单部分成交时剩余数量计入后续子订单，
+// ctx 结束时撤销未完成的子订单并返回已成交部分的报告
+func Execute(ctx context.Context, venue ExecVenue, req ExecRequest) (*ExecReport, error) {
+	if req.Side != "buy" && req.Side != "sell" {
+		return nil, fmt.Errorf("不支持的订单方向: %s", req.Side)
+	}
+	if req.Sz <= 0 {
+		return nil, fmt.Errorf("母单数量必须大于 0")
+	}
+	if req.Interval <= 0 {
+		req.Interval = defaultExecInterval
+	}
+	switch req.Algo {
+	case ExecTwap:
+		if req.Duration <= 0 {
+			return nil, fmt.Errorf("TWAP 必须指定 Duration")
+		}
+		if req.Slices <= 0 {
+			req.Slices = int(req.Duration / req.Interval)
+		}
+		if req.Slices <= 0 {
+			req.Slices = 1
+		}
+		if req.ChildTimeout <= 0 {
+			req.ChildTimeout = req.Duration / time.Duration(req.Slices)
+		}
+	case ExecIceberg:
+		if req.VisibleSz <= 0 || req.LimitPx <= 0 {
+			return nil, fmt.Errorf("冰山委托必须指定 VisibleSz 和 LimitPx")
+		}
+	case ExecPov:
+		if req.Participation <= 0 || req.Participation > 1 {
+			return nil, fmt.Errorf("POV 参与率必须在 0 和 1 之间: %v", req.Participation)
+		}
+		if req.ChildTimeout <= 0 {
+			req.ChildTimeout = req.Interval
+		}
+	default:
+		return nil, fmt.Errorf("不支持的执行算法: %s", req.Algo)
+	}
+
+	instrument, err := venue.Instrument(req.InstId)
+	if err != nil {
+		return nil, err
+	}
+	arrivalPx, err := venue.LastPrice(req.InstId)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	report := &ExecReport{InstId: req.InstId, Side: req.Side, Algo: req.Algo, Requested: req.Sz, ArrivalPx: arrivalPx}
+	if req.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, start.Add(req.Duration+req.ChildTimeout))
+		defer cancel()
+	}
+
+	e := &executor{venue: venue, req: req, instrument: instrument, report: report}
+	switch req.Algo {
+	case ExecTwap:
+		err = e.twap(ctx, start)
+	case ExecIceberg:
+		err = e.iceberg(ctx)
+	case ExecPov:
+		err = e.pov(ctx, start)
+	}
+	report.Residual = math.Max(req.Sz-report.Filled, 0)
+	if ctx.Err() != nil && report.Residual >= instrument.MinSz && report.Residual > 0 {
+		report.Canceled = true
+	}
+	report.finish(start)
+	return report, err
+}
+
+type executor struct {
+	venue      ExecVenue
+	req        ExecRequest
+	instrument ExecInstrument
+	report     *ExecReport
+}
+
+func (e *executor) remaining() float64 {
+	return e.req.Sz - e.report.Filled
+}
+
+func (e *executor) roundSz(sz float64) float64 {
+	return e.instrument.roundSz(sz)
+}
+
+// roundSz 按 LotSz 向下取整，低于 MinSz 时返回 0
+func (i ExecInstrument) roundSz(sz float64) float64 {
+	if i.LotSz > 0 {
+		// 加上微小偏移，避免浮点误差导致少一个精度单位
+		sz = math.Floor(sz/i.LotSz+1e-9) * i.LotSz
+	}
+	if sz < i.MinSz || sz <= 0 {
+		return 0
+	}
+	return sz
+}
+
+func (e *executor) twap(ctx context.Context, start time.Time) error {
+	for i := 0; i < e.req.Slices; i++ {
+		next := start.Add(e.req.Duration * time.Duration(i) / time.Duration(e.req.Slices))
+		if err := sleepUntil(ctx, next); err != nil {
+			return nil
+		}
+		// 未成交部分平均分摊到剩余的子订单
+		sz := e.roundSz(e.remaining() / float64(e.req.Slices-i))
+		if i == e.req.Slices-1 {
+			sz = e.roundSz(e.remaining())
+		}
+		if sz <= 0 {
+			continue
+		}
+		if err := e.child(ctx, sz, e.req.ChildTimeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *executor) iceberg(ctx context.Context) error {
+	for {
+		sz := e.roundSz(math.Min(e.req.VisibleSz, e.remaining()))
+		if sz <= 0 || ctx.Err() != nil {
+			return nil
+		}
+		if err := e.child(ctx, sz, e.req.ChildTimeout); err != nil {
+			return err
+		}
+	}
+}
+
+func (e *executor) pov(ctx context.Context, start time.Time) error {
+	since := start.UnixMilli()
+	var owed float64
+	for {
+		if err := sleepUntil(ctx, time.Now().Add(e.req.Interval)); err != nil {
+			return nil
+		}
+		now := time.Now().UnixMilli()
+		volume, err := e.venue.TradedVolume(e.req.InstId, since)
+		if err != nil {
+			fmt.Printf("[Exec] %s 获取成交量失败: %v\n", e.req.InstId, err)
+			continue
+		}
+		since = now
+		// 不足最小下单数量的部分累计到下一次
+		owed = math.Min(owed+volume*e.req.Participation, e.remaining())
+		sz := e.roundSz(owed)
+		if sz <= 0 {
+			if e.roundSz(e.remaining()) <= 0 {
+				return nil
+			}
+			continue
+		}
+		before := e.report.Filled
+		if err := e.child(ctx, sz, e.req.ChildTimeout); err != nil {
+			return err
+		}
+		owed -= e.report.Filled - before
+		if e.roundSz(e.remaining()) <= 0 {
+			return nil
+		}
+	}
+}
+
+// child 下一笔子订单并等待结束，限价单超过 timeout 未完全成交时撤单，timeout 为 0 时一直等待
+func (e *executor) child(ctx context.Context, sz float64, timeout time.Duration) error {
+	order := ExecChildOrder{InstId: e.req.InstId, Side: e.req.Side, Sz: sz, Px: e.req.LimitPx}
+	orderId, err := e.venue.PlaceChild(order)
+	if err != nil {
+		return err
+	}
+	fill := ExecFill{OrderId: orderId, Sz: sz, Time: time.Now()}
+
+	var deadline <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+	ticker := time.NewTicker(defaultExecPoll)
+	defer ticker.Stop()
+
+	canceled := false
+	for polls := 0; ; polls++ {
+		status, err := e.venue.ChildStatus(e.req.InstId, orderId)
+		if err != nil {
+			fmt.Printf("[Exec] 查询子订单 %s 失败: %v\n", orderId, err)
+		} else {
+			fill.Filled, fill.AvgPx = status.Filled, status.AvgPx
+			if status.Done {
+				break
+			}
+		}
+		if canceled {
+			// 已撤单，继续查询直到交易所确认结束，保证部分成交数量准确
+			if polls >= defaultExecCancelPolls {
+				fmt.Printf("[Exec] 子订单 %s 撤单后仍未确认结束，按已查询到的成交数量计算\n", orderId)
+				break
+			}
+			<-ticker.C
+			continue
+		}
+		select {
+		case <-ticker.C:
+			continue
+		case <-deadline:
+		case <-ctx.Done():
+		}
+		polls = 0
+		if err := e.venue.CancelChild(e.req.InstId, orderId); err != nil {
+			fmt.Printf("[Exec] 撤销子订单 %s 失败: %v\n", orderId, err)
+		}
+		canceled = true
+	}
+
+	fmt.Printf("[Exec] 子订单 %s 数量 %v，成交 %v，均价 %v\n", orderId, sz, fill.Filled, fill.AvgPx)
+	e.report.addFill(fill)
+	return nil
+}
+
+func sleepUntil(ctx context.Context, t time.Time) error {
+	d := time.Until(t)
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
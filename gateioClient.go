@@ -153,6 +153,52 @@ func (g *GateIOClient) SetPositionLever(instId, lever string) error {
 	return nil
 }
 
+// GetLeverage 查询 USDT 永续合约的当前杠杆倍数，全仓模式返回全仓杠杆上限
+func (g *GateIOClient) GetLeverage(target LeverageTarget) (float64, error) {
+	contract, err := normalizeGateioInstId(target.InstId)
+	if err != nil {
+		return 0, err
+	}
+	position, _, err := g.Client.FuturesApi.GetPosition(g.Ctx, gateDefaultSettle, contract)
+	if err != nil {
+		return 0, err
+	}
+	// Gate 的 leverage 为 0 表示全仓
+	lever, _ := strconv.ParseFloat(position.Leverage, 64)
+	if target.MgnMode == "cross" {
+		if lever != 0 {
+			return 0, nil
+		}
+		return strconv.ParseFloat(position.CrossLeverageLimit, 64)
+	}
+	return lever, nil
+}
+
+// EnsureLeverage 校验目标杠杆不超过合约的最大杠杆，与当前杠杆不同时才设置，
+// 全仓模式下设置 leverage 为 0 并通过 cross_leverage_limit 指定杠杆
+func (g *GateIOClient) EnsureLeverage(target LeverageTarget) (*LeverageResult, error) {
+	contract, err := normalizeGateioInstId(target.InstId)
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := g.Client.FuturesApi.GetFuturesContract(g.Ctx, gateDefaultSettle, contract)
+	if err != nil {
+		return nil, err
+	}
+	maxLever, _ := strconv.ParseFloat(res.LeverageMax, 64)
+
+	return ensureLeverage(target, maxLever, func() (float64, error) {
+		return g.GetLeverage(target)
+	}, func() error {
+		if target.MgnMode == "cross" {
+			_, _, err := g.Client.FuturesApi.UpdatePositionLeverage(g.Ctx, gateDefaultSettle, contract, "0",
+				&gateapi.UpdatePositionLeverageOpts{CrossLeverageLimit: optional.NewString(formatLever(target.Lever))})
+			return err
+		}
+		return g.SetPositionLever(contract, formatLever(target.Lever))
+	})
+}
+
 func (g *GateIOClient) GetInstruments(instId string) (float64, error) {
 	res, _, err := g.Client.FuturesApi.GetFuturesContract(g.Ctx, "usdt", instId)
 	if err != nil {
@@ -164,3 +210,12 @@ func (g *GateIOClient) GetInstruments(instId string) (float64, error) {
 		return 0, fmt.Errorf("解析value失败: %v", err)
 	}
 }
+
+// GetUserId 获取当前 API Key 对应的用户ID，订阅合约 WebSocket 私有频道时需要
+func (g *GateIOClient) GetUserId() (int64, error) {
+	detail, _, err := g.Client.AccountApi.GetAccountDetail(g.Ctx)
+	if err != nil {
+		return 0, err
+	}
+	return detail.UserId, nil
+}
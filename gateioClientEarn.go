@@ -0,0 +1,107 @@
+package galatvtr
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/antihax/optional"
+	"github.com/gateio/gateapi-go/v6"
+)
+
+// GetUniCurrency 获取余币宝（Uni 理财）币种信息，包含最小出借数量和利率范围
+func (g *GateIOClient) GetUniCurrency(ccy string) (*gateapi.UniCurrency, error) {
+	currency, _, err := g.Client.EarnUniApi.GetUniCurrency(g.Ctx, ccy)
+	if err != nil {
+		return nil, err
+	}
+	return &currency, nil
+}
+
+// GetUniLends 获取余币宝持仓列表，ccy 为空时查询全部币种
+func (g *GateIOClient) GetUniLends(ccy string) ([]gateapi.UniLend, error) {
+	opts := &gateapi.ListUserUniLendsOpts{}
+	if ccy != "" {
+		opts.Currency = optional.NewString(ccy)
+	}
+	lends, _, err := g.Client.EarnUniApi.ListUserUniLends(g.Ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return lends, nil
+}
+
+// GetUniLendBalance 获取余币宝中指定币种的可赎回数量（不含赎回中的数量）
+func (g *GateIOClient) GetUniLendBalance(ccy string) (float64, error) {
+	lends, err := g.GetUniLends(ccy)
+	if err != nil {
+		return 0, err
+	}
+	for _, lend := range lends {
+		if lend.Currency != ccy {
+			continue
+		}
+		values, err := parseFloats([]string{lend.CurrentAmount, lend.FrozenAmount})
+		if err != nil {
+			return 0, err
+		}
+		return values[0] - values[1], nil
+	}
+	return 0, nil
+}
+
+// UniLend 余币宝申购，资金从现货账户转出，minRate 为最低小时利率，为空时使用币种最低利率
+func (g *GateIOClient) UniLend(ccy, amt, minRate string) error {
+	if minRate == "" {
+		currency, err := g.GetUniCurrency(ccy)
+		if err != nil {
+			return err
+		}
+		minRate = currency.MinRate
+	}
+
+	// 打印请求参数
+	fmt.Printf("余币宝申购参数: ccy=%s amt=%s minRate=%s\n", ccy, amt, minRate)
+
+	_, err := g.Client.EarnUniApi.CreateUniLend(g.Ctx, gateapi.CreateUniLend{
+		Currency: ccy,
+		Amount:   amt,
+		Type:     "lend",
+		MinRate:  minRate,
+	})
+	if err != nil {
+		return fmt.Errorf("余币宝申购失败: %v", err)
+	}
+	return nil
+}
+
+// UniRedeem 余币宝赎回，资金赎回到现货账户
+func (g *GateIOClient) UniRedeem(ccy, amt string) error {
+	// 打印请求参数
+	fmt.Printf("余币宝赎回参数: ccy=%s amt=%s\n", ccy, amt)
+
+	_, err := g.Client.EarnUniApi.CreateUniLend(g.Ctx, gateapi.CreateUniLend{
+		Currency: ccy,
+		Amount:   amt,
+		Type:     "redeem",
+	})
+	if err != nil {
+		return fmt.Errorf("余币宝赎回失败: %v", err)
+	}
+	return nil
+}
+
+// GetUniInterest 获取余币宝指定币种的累计收益
+func (g *GateIOClient) GetUniInterest(ccy string) (float64, error) {
+	interest, _, err := g.Client.EarnUniApi.GetUniInterest(g.Ctx, ccy)
+	if err != nil {
+		return 0, err
+	}
+	if interest.Interest == "" {
+		return 0, nil
+	}
+	valueFloat, err := strconv.ParseFloat(interest.Interest, 64)
+	if err != nil {
+		return 0, fmt.Errorf("解析收益失败: %v", err)
+	}
+	return valueFloat, nil
+}
@@ -0,0 +1,103 @@
+package galatvtr
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GateZhuanbiRedemptionAllToAccountBalance 将余币宝中的币全部赎回到现货账户，再划转到 to 指定的交易账户，
+// to 为现货账户时只赎回不划转，返回目标账户本次可用于交易的数量。
+// 部分到账或超时时仍会划转已到账的部分并返回该数量，同时返回错误；
+// 赎回后划转失败时返回现货账户中的数量和错误，资金已在现货账户，不应重复赎回
+func (g *GateIOClient) GateZhuanbiRedemptionAllToAccountBalance(ticker string, to GateAccount) (float64, error) {
+	result, err := g.RedeemUniToTrading(context.Background(), ticker, to, RedemptionOptions{TransferPartial: true})
+	if result == nil {
+		return 0, err
+	}
+	if err != nil {
+		return result.Balance, err
+	}
+	if to == GateAccountSpot {
+		return result.Balance, result.incomplete()
+	}
+	return result.Transferred, result.incomplete()
+}
+
+// RedeemUniToTrading 将余币宝中的币全部赎回到现货账户，按 opts 等待到账，再划转到 to 指定的交易账户。
+// 赎回申请失败时直接返回错误；部分到账或超时且未设置 TransferPartial 时不划转，并返回错误
+func (g *GateIOClient) RedeemUniToTrading(ctx context.Context, ticker string, to GateAccount, opts RedemptionOptions) (*RedemptionResult, error) {
+	ccy, err := ConvertTvTrickerToSingleCoinName(ticker)
+	if err != nil {
+		fmt.Printf("[Redemption] 转换交易对失败: %v\n", err)
+		return nil, err
+	}
+	if isGateContractAccount(to) && ccy != strings.ToUpper(gateDefaultSettle) {
+		return nil, fmt.Errorf("合约账户只支持划转结算货币 %s，当前币种: %s", strings.ToUpper(gateDefaultSettle), ccy)
+	}
+
+	spotBalance, err := g.GetSpotAvailable(ccy)
+	if err != nil {
+		fmt.Printf("[Redemption] 查询现货账户余额失败: %v\n", err)
+		return nil, err
+	}
+
+	lendBalance, err := g.GetUniLendBalance(ccy)
+	if err != nil {
+		fmt.Printf("[Redemption] 查询余币宝余额失败: %v\n", err)
+		return nil, err
+	}
+
+	result := &RedemptionResult{
+		Ccy:       ccy,
+		Status:    RedemptionSkipped,
+		Requested: zhuanbiFormatFloat(ccy, lendBalance),
+		Balance:   spotBalance,
+	}
+
+	if result.Requested > 0 {
+		fmt.Printf("[Redemption] 开始从余币宝赎回...\n")
+		if err := g.UniRedeem(ccy, strconv.FormatFloat(result.Requested, 'f', 8, 64)); err != nil {
+			fmt.Printf("[Redemption] 余币宝赎回失败: %v\n", err)
+			return result, err
+		}
+
+		// 等待赎回到账，监控现货账户余额变化
+		fmt.Printf("[Redemption] 等待赎回到账，监控现货账户余额变化...\n")
+		start := time.Now()
+		status, balance, err := waitForRedemption(ctx, spotBalance, result.Requested, opts, func() (float64, error) {
+			return g.GetSpotAvailable(ccy)
+		})
+		result.Status = status
+		result.Balance = balance
+		result.Arrived = balance - spotBalance
+		result.Elapsed = time.Since(start)
+		fmt.Printf("[Redemption] 赎回结果: %s，申请 %.8f，到账 %.8f，耗时 %s\n", result.Status, result.Requested, result.Arrived, result.Elapsed)
+		if err != nil {
+			return result, err
+		}
+		if status != RedemptionRedeemed && !opts.TransferPartial {
+			return result, fmt.Errorf("赎回未全部到账: %s，申请 %.8f，到账 %.8f", status, result.Requested, result.Arrived)
+		}
+	}
+
+	amt := zhuanbiFormatFloat(ccy, result.Balance)
+	if to == GateAccountSpot || amt <= 0 {
+		return result, nil
+	}
+	_, err = g.Transfer(GateTransferRequest{
+		Ccy:  ccy,
+		Amt:  strconv.FormatFloat(amt, 'f', 8, 64),
+		From: GateAccountSpot,
+		To:   to,
+	})
+	if err != nil {
+		fmt.Printf("[Redemption] 资金划转失败: %v\n", err)
+		return result, err
+	}
+	result.Transferred = amt
+
+	return result, nil
+}
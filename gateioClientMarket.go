@@ -0,0 +1,399 @@
+package galatvtr
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/antihax/optional"
+	"github.com/gateio/gateapi-go/v6"
+)
+
+// Gate K线接口单次最多返回的数据条数
+const gateCandleLimit = 1000
+
+// gateIntervalSeconds 返回 Gate K线周期对应的秒数
+func gateIntervalSeconds(interval string) (int64, error) {
+	switch interval {
+	case "10s":
+		return 10, nil
+	case "1m":
+		return 60, nil
+	case "5m":
+		return 300, nil
+	case "15m":
+		return 900, nil
+	case "30m":
+		return 1800, nil
+	case "1h":
+		return 3600, nil
+	case "4h":
+		return 14400, nil
+	case "8h":
+		return 28800, nil
+	case "1d":
+		return 86400, nil
+	case "7d":
+		return 604800, nil
+	case "30d":
+		return 2592000, nil
+	default:
+		return 0, fmt.Errorf("不支持的K线周期: %s", interval)
+	}
+}
+
+// gateCandleWindows 将 [startMs, endMs] 拆分为单次请求不超过 gateCandleLimit 条的时间窗口（秒）
+func gateCandleWindows(startMs, endMs, intervalSec int64) [][2]int64 {
+	from := startMs / 1000
+	to := endMs / 1000
+	step := intervalSec * (gateCandleLimit - 1)
+	var windows [][2]int64
+	for from <= to {
+		windowEnd := from + step
+		if windowEnd > to {
+			windowEnd = to
+		}
+		windows = append(windows, [2]int64{from, windowEnd})
+		from = windowEnd + intervalSec
+	}
+	return windows
+}
+
+// GetSpotCandles 获取现货K线，startMs 和 endMs 为 Unix 毫秒时间戳，
+// 同时为 0 时返回最近 1000 根，否则自动分页获取整个区间，结果按时间升序
+func (g *GateIOClient) GetSpotCandles(instId, interval string, startMs, endMs int64) ([]Candle, error) {
+	pair, err := normalizeGateioInstId(instId)
+	if err != nil {
+		return nil, err
+	}
+	intervalSec, err := gateIntervalSeconds(interval)
+	if err != nil {
+		return nil, err
+	}
+
+	if startMs == 0 && endMs == 0 {
+		rows, _, err := g.Client.SpotApi.ListCandlesticks(g.Ctx, pair, &gateapi.ListCandlesticksOpts{
+			Limit:    optional.NewInt32(gateCandleLimit),
+			Interval: optional.NewString(interval),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return parseGateSpotCandles(rows)
+	}
+	if endMs == 0 {
+		endMs = time.Now().UnixMilli()
+	}
+
+	var candles []Candle
+	for _, window := range gateCandleWindows(startMs, endMs, intervalSec) {
+		rows, _, err := g.Client.SpotApi.ListCandlesticks(g.Ctx, pair, &gateapi.ListCandlesticksOpts{
+			From:     optional.NewInt64(window[0]),
+			To:       optional.NewInt64(window[1]),
+			Interval: optional.NewString(interval),
+		})
+		if err != nil {
+			return nil, err
+		}
+		page, err := parseGateSpotCandles(rows)
+		if err != nil {
+			return nil, err
+		}
+		candles = append(candles, page...)
+	}
+	return sortCandles(candles), nil
+}
+
+// parseGateSpotCandles 解析现货K线 [t, 成交额, close, high, low, open, 成交量, 是否完结]
+func parseGateSpotCandles(rows [][]string) ([]Candle, error) {
+	candles := make([]Candle, 0, len(rows))
+	for _, row := range rows {
+		if len(row) < 7 {
+			return nil, fmt.Errorf("K线数据格式错误: %v", row)
+		}
+		values, err := parseFloats(row[1:7])
+		if err != nil {
+			return nil, err
+		}
+		ts, err := strconv.ParseInt(row[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("解析K线时间失败: %v", err)
+		}
+		candle := Candle{
+			Ts:       ts * 1000,
+			VolQuote: values[0],
+			Close:    values[1],
+			High:     values[2],
+			Low:      values[3],
+			Open:     values[4],
+			Vol:      values[5],
+			Confirm:  true,
+		}
+		if len(row) > 7 {
+			candle.Confirm = row[7] == "true"
+		}
+		candles = append(candles, candle)
+	}
+	return sortCandles(candles), nil
+}
+
+// GetFutureCandles 获取合约K线，参数含义同 GetSpotCandles
+func (g *GateIOClient) GetFutureCandles(settle, contract, interval string, startMs, endMs int64) ([]Candle, error) {
+	instId, err := normalizeGateioInstId(contract)
+	if err != nil {
+		return nil, err
+	}
+	intervalSec, err := gateIntervalSeconds(interval)
+	if err != nil {
+		return nil, err
+	}
+
+	if startMs == 0 && endMs == 0 {
+		rows, _, err := g.Client.FuturesApi.ListFuturesCandlesticks(g.Ctx, gateSettle(settle), instId, &gateapi.ListFuturesCandlesticksOpts{
+			Limit:    optional.NewInt32(gateCandleLimit),
+			Interval: optional.NewString(interval),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return parseGateFutureCandles(rows, intervalSec)
+	}
+	if endMs == 0 {
+		endMs = time.Now().UnixMilli()
+	}
+
+	var candles []Candle
+	for _, window := range gateCandleWindows(startMs, endMs, intervalSec) {
+		rows, _, err := g.Client.FuturesApi.ListFuturesCandlesticks(g.Ctx, gateSettle(settle), instId, &gateapi.ListFuturesCandlesticksOpts{
+			From:     optional.NewInt64(window[0]),
+			To:       optional.NewInt64(window[1]),
+			Interval: optional.NewString(interval),
+		})
+		if err != nil {
+			return nil, err
+		}
+		page, err := parseGateFutureCandles(rows, intervalSec)
+		if err != nil {
+			return nil, err
+		}
+		candles = append(candles, page...)
+	}
+	return sortCandles(candles), nil
+}
+
+// parseGateFutureCandles 解析合约K线，Gate 不返回完结标记，按当前时间推算
+func parseGateFutureCandles(rows []gateapi.FuturesCandlestick, intervalSec int64) ([]Candle, error) {
+	now := time.Now().Unix()
+	candles := make([]Candle, 0, len(rows))
+	for _, row := range rows {
+		values, err := parseFloats([]string{row.O, row.H, row.L, row.C, row.Sum})
+		if err != nil {
+			return nil, err
+		}
+		ts := int64(row.T)
+		candles = append(candles, Candle{
+			Ts:       ts * 1000,
+			Open:     values[0],
+			High:     values[1],
+			Low:      values[2],
+			Close:    values[3],
+			Vol:      float64(row.V),
+			VolQuote: values[4],
+			Confirm:  ts+intervalSec <= now,
+		})
+	}
+	return sortCandles(candles), nil
+}
+
+// GetSpotOrderBook 获取现货深度快照，limit 为每侧档位数量
+func (g *GateIOClient) GetSpotOrderBook(instId string, limit int32) (*OrderBook, error) {
+	pair, err := normalizeGateioInstId(instId)
+	if err != nil {
+		return nil, err
+	}
+	book, _, err := g.Client.SpotApi.ListOrderBook(g.Ctx, pair, &gateapi.ListOrderBookOpts{
+		Limit: optional.NewInt32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &OrderBook{InstId: pair, Ts: book.Current}
+	if result.Asks, err = parseGateSpotLevels(book.Asks); err != nil {
+		return nil, err
+	}
+	if result.Bids, err = parseGateSpotLevels(book.Bids); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func parseGateSpotLevels(rows [][]string) ([]OrderBookLevel, error) {
+	levels := make([]OrderBookLevel, 0, len(rows))
+	for _, row := range rows {
+		if len(row) < 2 {
+			return nil, fmt.Errorf("深度数据格式错误: %v", row)
+		}
+		values, err := parseFloats(row[:2])
+		if err != nil {
+			return nil, err
+		}
+		levels = append(levels, OrderBookLevel{Px: values[0], Sz: values[1]})
+	}
+	return levels, nil
+}
+
+// GetFutureOrderBook 获取合约深度快照，limit 为每侧档位数量
+func (g *GateIOClient) GetFutureOrderBook(settle, contract string, limit int32) (*OrderBook, error) {
+	instId, err := normalizeGateioInstId(contract)
+	if err != nil {
+		return nil, err
+	}
+	book, _, err := g.Client.FuturesApi.ListFuturesOrderBook(g.Ctx, gateSettle(settle), instId, &gateapi.ListFuturesOrderBookOpts{
+		Limit: optional.NewInt32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &OrderBook{InstId: instId, Ts: int64(book.Current * 1000)}
+	if result.Asks, err = parseGateFutureLevels(book.Asks); err != nil {
+		return nil, err
+	}
+	if result.Bids, err = parseGateFutureLevels(book.Bids); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func parseGateFutureLevels(items []gateapi.FuturesOrderBookItem) ([]OrderBookLevel, error) {
+	levels := make([]OrderBookLevel, 0, len(items))
+	for _, item := range items {
+		px, err := strconv.ParseFloat(item.P, 64)
+		if err != nil {
+			return nil, fmt.Errorf("解析深度价格失败: %v", err)
+		}
+		levels = append(levels, OrderBookLevel{Px: px, Sz: float64(item.S)})
+	}
+	return levels, nil
+}
+
+// GetSpotTrades 获取现货最近成交，limit 最大 1000
+func (g *GateIOClient) GetSpotTrades(instId string, limit int32) ([]MarketTrade, error) {
+	pair, err := normalizeGateioInstId(instId)
+	if err != nil {
+		return nil, err
+	}
+	trades, _, err := g.Client.SpotApi.ListTrades(g.Ctx, pair, &gateapi.ListTradesOpts{
+		Limit: optional.NewInt32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]MarketTrade, 0, len(trades))
+	for _, trade := range trades {
+		values, err := parseFloats([]string{trade.Price, trade.Amount, trade.CreateTimeMs})
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, MarketTrade{
+			TradeId: trade.Id,
+			InstId:  pair,
+			Side:    trade.Side,
+			Px:      values[0],
+			Sz:      values[1],
+			Ts:      int64(values[2]),
+		})
+	}
+	return result, nil
+}
+
+// GetFutureTrades 获取合约最近成交，size 为负表示卖方吃单
+func (g *GateIOClient) GetFutureTrades(settle, contract string, limit int32) ([]MarketTrade, error) {
+	instId, err := normalizeGateioInstId(contract)
+	if err != nil {
+		return nil, err
+	}
+	trades, _, err := g.Client.FuturesApi.ListFuturesTrades(g.Ctx, gateSettle(settle), instId, &gateapi.ListFuturesTradesOpts{
+		Limit: optional.NewInt32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]MarketTrade, 0, len(trades))
+	for _, trade := range trades {
+		px, err := strconv.ParseFloat(trade.Price, 64)
+		if err != nil {
+			return nil, fmt.Errorf("解析成交价格失败: %v", err)
+		}
+		side, size := "buy", trade.Size
+		if size < 0 {
+			side, size = "sell", -size
+		}
+		result = append(result, MarketTrade{
+			TradeId: strconv.FormatInt(trade.Id, 10),
+			InstId:  instId,
+			Side:    side,
+			Px:      px,
+			Sz:      float64(size),
+			Ts:      int64(trade.CreateTimeMs * 1000), // create_time_ms 为带毫秒小数的秒
+		})
+	}
+	return result, nil
+}
+
+// GetFundingRateHistory 获取合约历史资金费率，startMs 和 endMs 为 0 时不限制
+func (g *GateIOClient) GetFundingRateHistory(settle, contract string, startMs, endMs int64) ([]FundingRate, error) {
+	instId, err := normalizeGateioInstId(contract)
+	if err != nil {
+		return nil, err
+	}
+	opts := &gateapi.ListFuturesFundingRateHistoryOpts{
+		Limit: optional.NewInt32(gateCandleLimit),
+	}
+	if startMs > 0 {
+		opts.From = optional.NewInt64(startMs / 1000)
+	}
+	if endMs > 0 {
+		opts.To = optional.NewInt64(endMs / 1000)
+	}
+	records, _, err := g.Client.FuturesApi.ListFuturesFundingRateHistory(g.Ctx, gateSettle(settle), instId, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]FundingRate, 0, len(records))
+	for _, record := range records {
+		rate, err := strconv.ParseFloat(record.R, 64)
+		if err != nil {
+			return nil, fmt.Errorf("解析资金费率失败: %v", err)
+		}
+		result = append(result, FundingRate{InstId: instId, Rate: rate, Ts: record.T * 1000})
+	}
+	return result, nil
+}
+
+// GetContractStats 获取合约统计数据（持仓量、多空比、爆仓量），interval 如 5m/1h/1d，startMs 为 0 时返回最近数据
+func (g *GateIOClient) GetContractStats(settle, contract, interval string, startMs int64, limit int32) ([]gateapi.ContractStat, error) {
+	instId, err := normalizeGateioInstId(contract)
+	if err != nil {
+		return nil, err
+	}
+	opts := &gateapi.ListContractStatsOpts{}
+	if interval != "" {
+		opts.Interval = optional.NewString(interval)
+	}
+	if limit > 0 {
+		opts.Limit = optional.NewInt32(limit)
+	}
+	if startMs > 0 {
+		opts.From = optional.NewInt64(startMs / 1000)
+	}
+	stats, _, err := g.Client.FuturesApi.ListContractStats(g.Ctx, gateSettle(settle), instId, opts)
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
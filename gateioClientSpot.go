@@ -0,0 +1,225 @@
+package galatvtr
+
+import (
+	"fmt"
+
+	"github.com/antihax/optional"
+	"github.com/gateio/gateapi-go/v6"
+)
+
+const (
+	// Gate 分页接口单页最大条数
+	gatePageLimit = 1000
+	// Gate 批量下单单次最多订单数
+	gateBatchCreateLimit = 10
+	// Gate 批量撤单单次最多订单数
+	gateBatchCancelLimit = 20
+)
+
+// GetOpenOrders 获取未完成的现货订单，instId 为空时查询全部交易对
+func (g *GateIOClient) GetOpenOrders(instId string) ([]gateapi.Order, error) {
+	if instId == "" {
+		var result []gateapi.Order
+		for page := int32(1); ; page++ {
+			openOrders, _, err := g.Client.SpotApi.ListAllOpenOrders(g.Ctx, &gateapi.ListAllOpenOrdersOpts{
+				Page:  optional.NewInt32(page),
+				Limit: optional.NewInt32(100),
+			})
+			if err != nil {
+				return nil, err
+			}
+			// limit 限制的是每个交易对返回的订单数，没有交易对满 100 条时已是最后一页
+			full := false
+			for _, pair := range openOrders {
+				result = append(result, pair.Orders...)
+				full = full || len(pair.Orders) >= 100
+			}
+			if !full {
+				return result, nil
+			}
+		}
+	}
+
+	pair, err := normalizeGateioInstId(instId)
+	if err != nil {
+		return nil, err
+	}
+	var result []gateapi.Order
+	for page := int32(1); ; page++ {
+		orders, _, err := g.Client.SpotApi.ListOrders(g.Ctx, pair, "open", &gateapi.ListOrdersOpts{
+			Page:  optional.NewInt32(page),
+			Limit: optional.NewInt32(100),
+		})
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, orders...)
+		if len(orders) < 100 {
+			return result, nil
+		}
+	}
+}
+
+// CancelAllOrders 撤销指定交易对的全部挂单，side 为空时撤销买卖两个方向
+func (g *GateIOClient) CancelAllOrders(instId, side string) ([]gateapi.OrderCancel, error) {
+	pair, err := normalizeGateioInstId(instId)
+	if err != nil {
+		return nil, err
+	}
+	opts := &gateapi.CancelOrdersOpts{
+		CurrencyPair: optional.NewString(pair),
+	}
+	if side != "" {
+		opts.Side = optional.NewString(side)
+	}
+	orders, _, err := g.Client.SpotApi.CancelOrders(g.Ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// PlaceBatchSpotOrders 批量下单，超过单次上限时自动分批提交，
+// 单个订单失败不会返回 error，需检查结果中的 Succeeded 和 Message
+func (g *GateIOClient) PlaceBatchSpotOrders(orders []gateapi.Order) ([]gateapi.BatchOrder, error) {
+	// 复制一份再规范化交易对，不修改调用方的切片
+	orders = append([]gateapi.Order(nil), orders...)
+	for i := range orders {
+		pair, err := normalizeGateioInstId(orders[i].CurrencyPair)
+		if err != nil {
+			return nil, err
+		}
+		orders[i].CurrencyPair = pair
+	}
+
+	// 打印order
+	fmt.Printf("批量下单参数: %+v\n", orders)
+
+	var result []gateapi.BatchOrder
+	for start := 0; start < len(orders); start += gateBatchCreateLimit {
+		end := start + gateBatchCreateLimit
+		if end > len(orders) {
+			end = len(orders)
+		}
+		batch, _, err := g.Client.SpotApi.CreateBatchOrders(g.Ctx, orders[start:end], nil)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, batch...)
+	}
+	return result, nil
+}
+
+// CancelBatchOrders 批量撤单，超过单次上限时自动分批提交，
+// 单个订单失败不会返回 error，需检查结果中的 Succeeded 和 Message
+func (g *GateIOClient) CancelBatchOrders(requests []gateapi.CancelBatchOrder) ([]gateapi.CancelOrderResult, error) {
+	// 复制一份再规范化交易对，不修改调用方的切片
+	requests = append([]gateapi.CancelBatchOrder(nil), requests...)
+	for i := range requests {
+		pair, err := normalizeGateioInstId(requests[i].CurrencyPair)
+		if err != nil {
+			return nil, err
+		}
+		requests[i].CurrencyPair = pair
+	}
+
+	var result []gateapi.CancelOrderResult
+	for start := 0; start < len(requests); start += gateBatchCancelLimit {
+		end := start + gateBatchCancelLimit
+		if end > len(requests) {
+			end = len(requests)
+		}
+		batch, _, err := g.Client.SpotApi.CancelBatchOrders(g.Ctx, requests[start:end], nil)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, batch...)
+	}
+	return result, nil
+}
+
+// AmendOrder 修改挂单的数量或价格，amount 和 price 为空时表示不修改
+func (g *GateIOClient) AmendOrder(orderId, instId, amount, price string) (*gateapi.Order, error) {
+	if amount == "" && price == "" {
+		return nil, fmt.Errorf("amount 和 price 至少需要提供一个")
+	}
+	pair, err := normalizeGateioInstId(instId)
+	if err != nil {
+		return nil, err
+	}
+	order, _, err := g.Client.SpotApi.AmendOrder(g.Ctx, orderId, gateapi.OrderPatch{
+		CurrencyPair: pair,
+		Amount:       amount,
+		Price:        price,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// GetMyTrades 获取现货成交明细，自动翻页获取 [startMs, endMs] 区间内的全部记录，
+// instId 为空时查询全部交易对，orderId 不为空时只查询该订单的成交
+func (g *GateIOClient) GetMyTrades(instId, orderId string, startMs, endMs int64) ([]gateapi.Trade, error) {
+	opts := &gateapi.ListMyTradesOpts{
+		Limit: optional.NewInt32(gatePageLimit),
+	}
+	if instId != "" {
+		pair, err := normalizeGateioInstId(instId)
+		if err != nil {
+			return nil, err
+		}
+		opts.CurrencyPair = optional.NewString(pair)
+	}
+	if orderId != "" {
+		opts.OrderId = optional.NewString(orderId)
+	}
+	if startMs > 0 {
+		opts.From = optional.NewInt64(startMs / 1000)
+	}
+	if endMs > 0 {
+		opts.To = optional.NewInt64(endMs / 1000)
+	}
+
+	var result []gateapi.Trade
+	for page := int32(1); ; page++ {
+		opts.Page = optional.NewInt32(page)
+		trades, _, err := g.Client.SpotApi.ListMyTrades(g.Ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, trades...)
+		if len(trades) < gatePageLimit {
+			return result, nil
+		}
+	}
+}
+
+// GetSpotAccountBook 获取现货账户流水，自动翻页获取 [startMs, endMs] 区间内的全部记录，ccy 为空时查询全部币种
+func (g *GateIOClient) GetSpotAccountBook(ccy string, startMs, endMs int64) ([]gateapi.SpotAccountBook, error) {
+	opts := &gateapi.ListSpotAccountBookOpts{
+		Limit: optional.NewInt32(gatePageLimit),
+	}
+	if ccy != "" {
+		opts.Currency = optional.NewString(ccy)
+	}
+	if startMs > 0 {
+		opts.From = optional.NewInt64(startMs / 1000)
+	}
+	if endMs > 0 {
+		opts.To = optional.NewInt64(endMs / 1000)
+	}
+
+	var result []gateapi.SpotAccountBook
+	for page := int32(1); ; page++ {
+		opts.Page = optional.NewInt32(page)
+		books, _, err := g.Client.SpotApi.ListSpotAccountBook(g.Ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, books...)
+		if len(books) < gatePageLimit {
+			return result, nil
+		}
+	}
+}
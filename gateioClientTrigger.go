@@ -0,0 +1,345 @@
+package galatvtr
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/antihax/optional"
+	"github.com/gateio/gateapi-go/v6"
+)
+
+const (
+	// Gate 合约默认结算货币
+	gateDefaultSettle = "usdt"
+	// Gate 价格触发单默认最长等待时间（秒）
+	gateDefaultTriggerExpiration = 86400 * 30
+)
+
+// isMarketOrdPx 判断委托价是否表示市价，与 OKX 一致，为空或 -1 表示市价
+func isMarketOrdPx(ordPx string) bool {
+	return ordPx == "" || ordPx == "-1"
+}
+
+// gateTriggerPriceType 将 OKX 风格的触发价类型转换为 Gate 的 price_type
+func gateTriggerPriceType(pxType string) (int32, error) {
+	switch pxType {
+	case "", "last":
+		return 0, nil
+	case "mark":
+		return 1, nil
+	case "index":
+		return 2, nil
+	default:
+		return 0, fmt.Errorf("不支持的触发价类型: %s", pxType)
+	}
+}
+
+// PlaceSpotTriggerOrder 现货止盈止损下单，止盈和止损分别生成一笔价格触发单
+func (g *GateIOClient) PlaceSpotTriggerOrder(request GateSpotTriggerOrderRequest) (*GateTriggerOrderResult, error) {
+	if request.TpTriggerPx == "" && request.SlTriggerPx == "" {
+		return nil, fmt.Errorf("止盈触发价和止损触发价至少需要提供一个")
+	}
+	if request.Side != "buy" && request.Side != "sell" {
+		return nil, fmt.Errorf("不支持的订单方向: %s", request.Side)
+	}
+	market, err := normalizeGateioInstId(request.InstId)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("现货止盈止损下单参数: %+v\n", request)
+
+	// 卖出平多：价格上涨到止盈价或下跌到止损价时触发；买入平空则相反
+	tpRule, slRule := ">=", "<="
+	if request.Side == "buy" {
+		tpRule, slRule = "<=", ">="
+	}
+
+	result := &GateTriggerOrderResult{}
+	if request.TpTriggerPx != "" {
+		id, err := g.createSpotTriggerOrder(market, request, request.TpTriggerPx, tpRule, request.TpOrdPx)
+		if err != nil {
+			return result, fmt.Errorf("现货止盈下单失败: %v", err)
+		}
+		result.TpOrderId = id
+	}
+	if request.SlTriggerPx != "" {
+		id, err := g.createSpotTriggerOrder(market, request, request.SlTriggerPx, slRule, request.SlOrdPx)
+		if err != nil {
+			// 止损下单失败时撤销已下的止盈，避免只留下单边触发单
+			if result.TpOrderId != 0 {
+				if _, cancelErr := g.CancelSpotTriggerOrder(result.TpOrderId); cancelErr != nil {
+					return result, fmt.Errorf("现货止损下单失败: %v，撤销止盈单 %d 失败: %v", err, result.TpOrderId, cancelErr)
+				}
+				result.TpOrderId = 0
+			}
+			return result, fmt.Errorf("现货止损下单失败: %v", err)
+		}
+		result.SlOrderId = id
+	}
+	return result, nil
+}
+
+func (g *GateIOClient) createSpotTriggerOrder(market string, request GateSpotTriggerOrderRequest, triggerPx, rule, ordPx string) (int64, error) {
+	account := request.Account
+	if account == "" {
+		account = "normal"
+	}
+	expiration := request.Expiration
+	if expiration == 0 {
+		expiration = gateDefaultTriggerExpiration
+	}
+
+	put := gateapi.SpotPricePutOrder{
+		Type:    "limit",
+		Side:    request.Side,
+		Price:   ordPx,
+		Amount:  request.Sz,
+		Account: account,
+		Text:    request.Text,
+	}
+	if isMarketOrdPx(ordPx) {
+		put.Type = "market"
+		put.Price = "0"
+		put.TimeInForce = "ioc"
+	}
+
+	res, _, err := g.Client.SpotApi.CreateSpotPriceTriggeredOrder(g.Ctx, gateapi.SpotPriceTriggeredOrder{
+		Trigger: gateapi.SpotPriceTrigger{
+			Price:      triggerPx,
+			Rule:       rule,
+			Expiration: expiration,
+		},
+		Put:    put,
+		Market: market,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.Id, nil
+}
+
+// GetSpotTriggerOrdersPending 获取现货未触发的价格触发单列表，instId 为空时查询全部
+func (g *GateIOClient) GetSpotTriggerOrdersPending(instId string) ([]gateapi.SpotPriceTriggeredOrder, error) {
+	return g.ListSpotTriggerOrders("open", instId)
+}
+
+// ListSpotTriggerOrders 按状态查询现货价格触发单，status 为 open 或 finished
+func (g *GateIOClient) ListSpotTriggerOrders(status, instId string) ([]gateapi.SpotPriceTriggeredOrder, error) {
+	opts := &gateapi.ListSpotPriceTriggeredOrdersOpts{}
+	if instId != "" {
+		market, err := normalizeGateioInstId(instId)
+		if err != nil {
+			return nil, err
+		}
+		opts.Market = optional.NewString(market)
+	}
+	orders, _, err := g.Client.SpotApi.ListSpotPriceTriggeredOrders(g.Ctx, status, opts)
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// GetSpotTriggerOrder 查询单个现货价格触发单
+func (g *GateIOClient) GetSpotTriggerOrder(orderId int64) (*gateapi.SpotPriceTriggeredOrder, error) {
+	order, _, err := g.Client.SpotApi.GetSpotPriceTriggeredOrder(g.Ctx, strconv.FormatInt(orderId, 10))
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// CancelSpotTriggerOrder 撤销单个现货价格触发单
+func (g *GateIOClient) CancelSpotTriggerOrder(orderId int64) (*gateapi.SpotPriceTriggeredOrder, error) {
+	order, _, err := g.Client.SpotApi.CancelSpotPriceTriggeredOrder(g.Ctx, strconv.FormatInt(orderId, 10))
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// CancelSpotTriggerOrders 撤销指定交易对的全部现货价格触发单，instId 为空时撤销全部
+func (g *GateIOClient) CancelSpotTriggerOrders(instId string) ([]gateapi.SpotPriceTriggeredOrder, error) {
+	opts := &gateapi.CancelSpotPriceTriggeredOrderListOpts{}
+	if instId != "" {
+		market, err := normalizeGateioInstId(instId)
+		if err != nil {
+			return nil, err
+		}
+		opts.Market = optional.NewString(market)
+	}
+	orders, _, err := g.Client.SpotApi.CancelSpotPriceTriggeredOrderList(g.Ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// PlaceFutureTriggerOrder 合约止盈止损下单，止盈和止损分别生成一笔价格触发单
+func (g *GateIOClient) PlaceFutureTriggerOrder(request GateFutureTriggerOrderRequest) (*GateTriggerOrderResult, error) {
+	if request.TpTriggerPx == "" && request.SlTriggerPx == "" {
+		return nil, fmt.Errorf("止盈触发价和止损触发价至少需要提供一个")
+	}
+	if request.Side != "buy" && request.Side != "sell" {
+		return nil, fmt.Errorf("不支持的订单方向: %s", request.Side)
+	}
+	if request.Sz < 0 {
+		return nil, fmt.Errorf("委托张数不能为负数: %d", request.Sz)
+	}
+
+	fmt.Printf("合约止盈止损下单参数: %+v\n", request)
+
+	// Gate rule 1 为价格大于等于触发价，2 为价格小于等于触发价
+	var tpRule, slRule int32 = 1, 2
+	if request.Side == "buy" {
+		tpRule, slRule = 2, 1
+	}
+
+	result := &GateTriggerOrderResult{}
+	if request.TpTriggerPx != "" {
+		id, err := g.createFutureTriggerOrder(request, request.TpTriggerPx, request.TpTriggerPxType, tpRule, request.TpOrdPx)
+		if err != nil {
+			return result, fmt.Errorf("合约止盈下单失败: %v", err)
+		}
+		result.TpOrderId = id
+	}
+	if request.SlTriggerPx != "" {
+		id, err := g.createFutureTriggerOrder(request, request.SlTriggerPx, request.SlTriggerPxType, slRule, request.SlOrdPx)
+		if err != nil {
+			// 止损下单失败时撤销已下的止盈，避免只留下单边触发单
+			if result.TpOrderId != 0 {
+				if _, cancelErr := g.CancelFutureTriggerOrder(request.Settle, result.TpOrderId); cancelErr != nil {
+					return result, fmt.Errorf("合约止损下单失败: %v，撤销止盈单 %d 失败: %v", err, result.TpOrderId, cancelErr)
+				}
+				result.TpOrderId = 0
+			}
+			return result, fmt.Errorf("合约止损下单失败: %v", err)
+		}
+		result.SlOrderId = id
+	}
+	return result, nil
+}
+
+func (g *GateIOClient) createFutureTriggerOrder(request GateFutureTriggerOrderRequest, triggerPx, triggerPxType string, rule int32, ordPx string) (int64, error) {
+	contract, err := normalizeGateioInstId(request.Contract)
+	if err != nil {
+		return 0, err
+	}
+	priceType, err := gateTriggerPriceType(triggerPxType)
+	if err != nil {
+		return 0, err
+	}
+	expiration := request.Expiration
+	if expiration == 0 {
+		expiration = gateDefaultTriggerExpiration
+	}
+
+	initial := gateapi.FuturesInitialOrder{
+		Contract:   contract,
+		Price:      ordPx,
+		Tif:        "gtc",
+		Text:       request.Text,
+		ReduceOnly: request.ReduceOnly,
+	}
+	if isMarketOrdPx(ordPx) {
+		initial.Price = "0"
+		initial.Tif = "ioc"
+	}
+	if request.Sz == 0 {
+		// 全部平仓：单向持仓用 close，双向持仓用 auto_size 指定方向，Gate 要求 auto_size 同时设置 reduce_only
+		switch request.PosSide {
+		case "long":
+			initial.AutoSize = "close_long"
+			initial.ReduceOnly = true
+		case "short":
+			initial.AutoSize = "close_short"
+			initial.ReduceOnly = true
+		default:
+			initial.Close = true
+		}
+	} else if request.Side == "sell" {
+		initial.Size = -request.Sz
+	} else {
+		initial.Size = request.Sz
+	}
+
+	res, _, err := g.Client.FuturesApi.CreatePriceTriggeredOrder(g.Ctx, gateSettle(request.Settle), gateapi.FuturesPriceTriggeredOrder{
+		Initial: initial,
+		Trigger: gateapi.FuturesPriceTrigger{
+			PriceType:  priceType,
+			Price:      triggerPx,
+			Rule:       rule,
+			Expiration: expiration,
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.Id, nil
+}
+
+// GetFutureTriggerOrdersPending 获取合约未触发的价格触发单列表，contract 为空时查询全部
+func (g *GateIOClient) GetFutureTriggerOrdersPending(settle, contract string) ([]gateapi.FuturesPriceTriggeredOrder, error) {
+	return g.ListFutureTriggerOrders(settle, "open", contract)
+}
+
+// ListFutureTriggerOrders 按状态查询合约价格触发单，status 为 open 或 finished
+func (g *GateIOClient) ListFutureTriggerOrders(settle, status, contract string) ([]gateapi.FuturesPriceTriggeredOrder, error) {
+	opts := &gateapi.ListPriceTriggeredOrdersOpts{}
+	if contract != "" {
+		instId, err := normalizeGateioInstId(contract)
+		if err != nil {
+			return nil, err
+		}
+		opts.Contract = optional.NewString(instId)
+	}
+	orders, _, err := g.Client.FuturesApi.ListPriceTriggeredOrders(g.Ctx, gateSettle(settle), status, opts)
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// GetFutureTriggerOrder 查询单个合约价格触发单
+func (g *GateIOClient) GetFutureTriggerOrder(settle string, orderId int64) (*gateapi.FuturesPriceTriggeredOrder, error) {
+	order, _, err := g.Client.FuturesApi.GetPriceTriggeredOrder(g.Ctx, gateSettle(settle), strconv.FormatInt(orderId, 10))
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// CancelFutureTriggerOrder 撤销单个合约价格触发单
+func (g *GateIOClient) CancelFutureTriggerOrder(settle string, orderId int64) (*gateapi.FuturesPriceTriggeredOrder, error) {
+	order, _, err := g.Client.FuturesApi.CancelPriceTriggeredOrder(g.Ctx, gateSettle(settle), strconv.FormatInt(orderId, 10))
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// CancelFutureTriggerOrders 撤销指定合约的全部价格触发单，contract 为空时撤销全部
+func (g *GateIOClient) CancelFutureTriggerOrders(settle, contract string) ([]gateapi.FuturesPriceTriggeredOrder, error) {
+	opts := &gateapi.CancelPriceTriggeredOrderListOpts{}
+	if contract != "" {
+		instId, err := normalizeGateioInstId(contract)
+		if err != nil {
+			return nil, err
+		}
+		opts.Contract = optional.NewString(instId)
+	}
+	orders, _, err := g.Client.FuturesApi.CancelPriceTriggeredOrderList(g.Ctx, gateSettle(settle), opts)
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// gateSettle 返回结算货币，未指定时使用 usdt
+func gateSettle(settle string) string {
+	if settle == "" {
+		return gateDefaultSettle
+	}
+	return settle
+}
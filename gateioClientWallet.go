@@ -0,0 +1,76 @@
+package galatvtr
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/antihax/optional"
+	"github.com/gateio/gateapi-go/v6"
+)
+
+// Transfer 钱包内账户划转，返回划转ID
+func (g *GateIOClient) Transfer(request GateTransferRequest) (int64, error) {
+	if request.From == request.To {
+		return 0, fmt.Errorf("转出账户和转入账户不能相同: %s", request.From)
+	}
+
+	// 打印请求参数
+	fmt.Printf("资金划转参数: %+v\n", request)
+
+	transfer := gateapi.Transfer{
+		Currency:     request.Ccy,
+		From:         string(request.From),
+		To:           string(request.To),
+		Amount:       request.Amt,
+		CurrencyPair: request.CurrencyPair,
+	}
+	if isGateContractAccount(request.From) || isGateContractAccount(request.To) {
+		transfer.Settle = gateSettle(request.Settle)
+	}
+	if (request.From == GateAccountMargin || request.To == GateAccountMargin) && request.CurrencyPair == "" {
+		return 0, fmt.Errorf("划转杠杆账户时 CurrencyPair 不能为空")
+	}
+
+	res, _, err := g.Client.WalletApi.Transfer(g.Ctx, transfer)
+	if err != nil {
+		return 0, fmt.Errorf("资金划转失败: %v", err)
+	}
+	return res.TxId, nil
+}
+
+func isGateContractAccount(account GateAccount) bool {
+	return account == GateAccountFutures || account == GateAccountDelivery
+}
+
+// GetSpotAvailable 获取现货账户指定币种的可用余额
+func (g *GateIOClient) GetSpotAvailable(ccy string) (float64, error) {
+	accounts, _, err := g.Client.SpotApi.ListSpotAccounts(g.Ctx, &gateapi.ListSpotAccountsOpts{
+		Currency: optional.NewString(ccy),
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, account := range accounts {
+		if account.Currency == ccy {
+			valueFloat, err := strconv.ParseFloat(account.Available, 64)
+			if err != nil {
+				return 0, fmt.Errorf("解析可用余额失败: %v", err)
+			}
+			return valueFloat, nil
+		}
+	}
+	return 0, nil
+}
+
+// GetFuturesAvailable 获取合约账户可用保证金
+func (g *GateIOClient) GetFuturesAvailable(settle string) (float64, error) {
+	account, _, err := g.Client.FuturesApi.ListFuturesAccounts(g.Ctx, gateSettle(settle))
+	if err != nil {
+		return 0, err
+	}
+	valueFloat, err := strconv.ParseFloat(account.Available, 64)
+	if err != nil {
+		return 0, fmt.Errorf("解析可用保证金失败: %v", err)
+	}
+	return valueFloat, nil
+}
@@ -0,0 +1,153 @@
+package galatvtr
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gateio/gateapi-go/v6"
+)
+
+// GateExecVenue 在 Gate 上执行拆单，合约数量为张数。
+// 现货市价买单按最新价格换算为计价货币数量下单，实际成交的交易货币数量会略有偏差
+type GateExecVenue struct {
+	Client  *GateIOClient
+	Futures bool   // 是否为合约，false 为现货
+	Settle  string // 合约结算货币，默认 usdt
+}
+
+func (v *GateExecVenue) Instrument(instId string) (ExecInstrument, error) {
+	market, err := normalizeGateioInstId(instId)
+	if err != nil {
+		return ExecInstrument{}, err
+	}
+	if v.Futures {
+		contract, _, err := v.Client.Client.FuturesApi.GetFuturesContract(v.Client.Ctx, gateSettle(v.Settle), market)
+		if err != nil {
+			return ExecInstrument{}, err
+		}
+		tickSz, _ := strconv.ParseFloat(contract.OrderPriceRound, 64)
+		ctVal, _ := strconv.ParseFloat(contract.QuantoMultiplier, 64)
+		return ExecInstrument{LotSz: 1, MinSz: float64(contract.OrderSizeMin), TickSz: tickSz, CtVal: ctVal}, nil
+	}
+	pair, _, err := v.Client.Client.SpotApi.GetCurrencyPair(v.Client.Ctx, market)
+	if err != nil {
+		return ExecInstrument{}, err
+	}
+	minSz, _ := strconv.ParseFloat(pair.MinBaseAmount, 64)
+	return ExecInstrument{
+		LotSz:  math.Pow10(-int(pair.AmountPrecision)),
+		MinSz:  minSz,
+		TickSz: math.Pow10(-int(pair.Precision)),
+		CtVal:  1,
+	}, nil
+}
+
+func (v *GateExecVenue) LastPrice(instId string) (float64, error) {
+	return (&GateStopExecutor{Client: v.Client, Futures: v.Futures, Settle: v.Settle}).LastPrice(instId)
+}
+
+// TradedVolume 按最近 1000 笔公共成交统计，成交过于活跃时会少算
+func (v *GateExecVenue) TradedVolume(instId string, sinceMs int64) (float64, error) {
+	var trades []MarketTrade
+	var err error
+	if v.Futures {
+		trades, err = v.Client.GetFutureTrades(v.Settle, instId, gatePageLimit)
+	} else {
+		trades, err = v.Client.GetSpotTrades(instId, gatePageLimit)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return sumTradedVolume(trades, sinceMs), nil
+}
+
+func (v *GateExecVenue) PlaceChild(order ExecChildOrder) (string, error) {
+	market, err := normalizeGateioInstId(order.InstId)
+	if err != nil {
+		return "", err
+	}
+	text := "t-exec" + strconv.FormatInt(time.Now().UnixNano(), 10)
+
+	if v.Futures {
+		size := int64(order.Sz)
+		if order.Side == "sell" {
+			size = -size
+		}
+		request := gateapi.FuturesOrder{Contract: market, Size: size, Price: "0", Tif: "ioc", Text: text}
+		if order.Px > 0 {
+			request.Price = strconv.FormatFloat(order.Px, 'f', -1, 64)
+			request.Tif = "gtc"
+		}
+		result, _, err := v.Client.Client.FuturesApi.CreateFuturesOrder(v.Client.Ctx, gateSettle(v.Settle), request, nil)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatInt(result.Id, 10), nil
+	}
+
+	request := gateapi.Order{
+		CurrencyPair: market,
+		Side:         order.Side,
+		Amount:       strconv.FormatFloat(order.Sz, 'f', -1, 64),
+		Type:         "market",
+		TimeInForce:  "ioc",
+		Text:         text,
+	}
+	if order.Px > 0 {
+		request.Type = "limit"
+		request.TimeInForce = "gtc"
+		request.Price = strconv.FormatFloat(order.Px, 'f', -1, 64)
+	} else if order.Side == "buy" {
+		px, err := v.LastPrice(market)
+		if err != nil {
+			return "", err
+		}
+		request.Amount = strconv.FormatFloat(order.Sz*px, 'f', 8, 64)
+	}
+	result, err := v.Client.PlaceSpotOrder(request)
+	if err != nil {
+		return "", err
+	}
+	return result.Id, nil
+}
+
+func (v *GateExecVenue) ChildStatus(instId, orderId string) (ExecChildStatus, error) {
+	market, err := normalizeGateioInstId(instId)
+	if err != nil {
+		return ExecChildStatus{}, err
+	}
+	if v.Futures {
+		order, _, err := v.Client.Client.FuturesApi.GetFuturesOrder(v.Client.Ctx, gateSettle(v.Settle), orderId)
+		if err != nil {
+			return ExecChildStatus{}, err
+		}
+		status := ExecChildStatus{
+			Filled: math.Abs(float64(order.Size - order.Left)),
+			Done:   order.Status == "finished",
+		}
+		status.AvgPx, _ = strconv.ParseFloat(order.FillPrice, 64)
+		return status, nil
+	}
+	order, err := v.Client.GetOrderStatus(orderId, market)
+	if err != nil {
+		return ExecChildStatus{}, err
+	}
+	status := ExecChildStatus{Done: order.Status != "open"}
+	status.Filled, _ = strconv.ParseFloat(order.FilledAmount, 64)
+	status.AvgPx, _ = strconv.ParseFloat(order.AvgDealPrice, 64)
+	return status, nil
+}
+
+func (v *GateExecVenue) CancelChild(instId, orderId string) error {
+	market, err := normalizeGateioInstId(instId)
+	if err != nil {
+		return err
+	}
+	if v.Futures {
+		_, _, err = v.Client.Client.FuturesApi.CancelFuturesOrder(v.Client.Ctx, gateSettle(v.Settle), orderId, nil)
+		return err
+	}
+	_, err = v.Client.CancelOrder(orderId, market)
+	return err
+}
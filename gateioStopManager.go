@@ -0,0 +1,156 @@
+package galatvtr
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/antihax/optional"
+	"github.com/gateio/gateapi-go/v6"
+)
+
+// GateStopExecutor 使用 Gate 价格触发单执行 StopManager 的止损单。
+// Gate 价格触发单不支持修改，移动止损时先下新单再撤旧单，避免中途没有止损保护
+type GateStopExecutor struct {
+	Client  *GateIOClient
+	Futures bool   // 是否为合约，false 为现货
+	Settle  string // 合约结算货币，默认 usdt
+}
+
+// NewGateStopManager 创建使用 Gate 价格触发单的止损管理器
+func NewGateStopManager(client *GateIOClient, futures bool, path string) (*StopManager, error) {
+	return NewStopManager(&GateStopExecutor{Client: client, Futures: futures}, path)
+}
+
+func (e *GateStopExecutor) LastPrice(instId string) (float64, error) {
+	market, err := normalizeGateioInstId(instId)
+	if err != nil {
+		return 0, err
+	}
+	var last string
+	if e.Futures {
+		tickers, _, err := e.Client.Client.FuturesApi.ListFuturesTickers(e.Client.Ctx, gateSettle(e.Settle),
+			&gateapi.ListFuturesTickersOpts{Contract: optional.NewString(market)})
+		if err != nil {
+			return 0, err
+		}
+		if len(tickers) == 0 {
+			return 0, fmt.Errorf("未获取到合约行情: %s", market)
+		}
+		last = tickers[0].Last
+	} else {
+		tickers, _, err := e.Client.Client.SpotApi.ListTickers(e.Client.Ctx,
+			&gateapi.ListTickersOpts{CurrencyPair: optional.NewString(market)})
+		if err != nil {
+			return 0, err
+		}
+		if len(tickers) == 0 {
+			return 0, fmt.Errorf("未获取到现货行情: %s", market)
+		}
+		last = tickers[0].Last
+	}
+	return strconv.ParseFloat(last, 64)
+}
+
+func (e *GateStopExecutor) Candles(instId, interval string, limit int) ([]Candle, error) {
+	intervalSec, err := gateIntervalSeconds(interval)
+	if err != nil {
+		return nil, err
+	}
+	endMs := time.Now().UnixMilli()
+	startMs := endMs - int64(limit)*intervalSec*1000
+	if e.Futures {
+		return e.Client.GetFutureCandles(e.Settle, instId, interval, startMs, endMs)
+	}
+	return e.Client.GetSpotCandles(instId, interval, startMs, endMs)
+}
+
+func (e *GateStopExecutor) PlaceStop(stop ManagedStop) (string, error) {
+	side := "sell"
+	if stop.Direction == "short" {
+		side = "buy"
+	}
+	stopPx := strconv.FormatFloat(stop.StopPx, 'f', -1, 64)
+
+	var result *GateTriggerOrderResult
+	if e.Futures {
+		sz, err := strconv.ParseInt(stop.Sz, 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("合约张数无效: %s", stop.Sz)
+		}
+		result, err = e.Client.PlaceFutureTriggerOrder(GateFutureTriggerOrderRequest{
+			Settle:      e.Settle,
+			Contract:    stop.InstId,
+			Side:        side,
+			Sz:          sz,
+			ReduceOnly:  true,
+			PosSide:     stop.PosSide,
+			SlTriggerPx: stopPx,
+		})
+		if err != nil {
+			return "", err
+		}
+	} else {
+		var err error
+		result, err = e.Client.PlaceSpotTriggerOrder(GateSpotTriggerOrderRequest{
+			InstId:      stop.InstId,
+			Side:        side,
+			Sz:          stop.Sz,
+			SlTriggerPx: stopPx,
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+	return strconv.FormatInt(result.SlOrderId, 10), nil
+}
+
+func (e *GateStopExecutor) MoveStop(stop ManagedStop, stopPx float64) (string, error) {
+	moved := stop
+	moved.StopPx = stopPx
+	orderId, err := e.PlaceStop(moved)
+	if err != nil {
+		return "", err
+	}
+	if err := e.CancelStop(stop); err != nil {
+		// 旧止损单可能仍然有效，撤销新止损单并返回错误，由调用方继续跟踪旧止损单，避免重复平仓
+		moved.OrderId = orderId
+		if cancelErr := e.CancelStop(moved); cancelErr != nil {
+			return "", fmt.Errorf("撤销旧止损单 %s 失败: %v，撤销新止损单 %s 也失败，请手动处理: %v", stop.OrderId, err, orderId, cancelErr)
+		}
+		return "", fmt.Errorf("撤销旧止损单 %s 失败，已撤销新止损单: %v", stop.OrderId, err)
+	}
+	return orderId, nil
+}
+
+func (e *GateStopExecutor) CancelStop(stop ManagedStop) error {
+	orderId, err := strconv.ParseInt(stop.OrderId, 10, 64)
+	if err != nil {
+		return fmt.Errorf("止损单ID无效: %s", stop.OrderId)
+	}
+	if e.Futures {
+		_, err = e.Client.CancelFutureTriggerOrder(e.Settle, orderId)
+	} else {
+		_, err = e.Client.CancelSpotTriggerOrder(orderId)
+	}
+	return err
+}
+
+func (e *GateStopExecutor) StopActive(stop ManagedStop) (bool, error) {
+	orderId, err := strconv.ParseInt(stop.OrderId, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("止损单ID无效: %s", stop.OrderId)
+	}
+	if e.Futures {
+		order, err := e.Client.GetFutureTriggerOrder(e.Settle, orderId)
+		if err != nil {
+			return false, err
+		}
+		return order.Status == "open", nil
+	}
+	order, err := e.Client.GetSpotTriggerOrder(orderId)
+	if err != nil {
+		return false, err
+	}
+	return order.Status == "open", nil
+}
@@ -0,0 +1,239 @@
+package galatvtr
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GateSpotTriggerOrderRequest 现货止盈止损（价格触发）下单请求，语义与 AlgoOrderRequest 的 tp/sl 参数一致
+type GateSpotTriggerOrderRequest struct {
+	InstId      string // 交易对，支持 TradingView 格式（如 BTCUSDT）或 Gate 格式（如 BTC_USDT）
+	Side        string // 触发后委托方向 buy/sell，平多仓为 sell，平空仓为 buy
+	Sz          string // 委托数量，限价单和市价卖单为交易货币数量，市价买单为计价货币数量
+	Account     string // 交易账户类型 normal/margin/unified，默认 normal
+	TpTriggerPx string // 止盈触发价，为空则不下止盈单
+	TpOrdPx     string // 止盈委托价，为空或 -1 时以市价委托
+	SlTriggerPx string // 止损触发价，为空则不下止损单
+	SlOrdPx     string // 止损委托价，为空或 -1 时以市价委托
+	Expiration  int32  // 触发条件最长等待时间（秒），0 使用默认值
+	Text        string // 订单备注
+}
+
+// GateFutureTriggerOrderRequest 合约止盈止损（价格触发）下单请求，语义与 AlgoOrderRequest 的 tp/sl 参数一致
+type GateFutureTriggerOrderRequest struct {
+	Settle          string // 结算货币，默认 usdt
+	Contract        string // 合约标识，支持 TradingView 格式（如 BTCUSDT.P）或 Gate 格式（如 BTC_USDT）
+	Side            string // 触发后委托方向 buy/sell，平多仓为 sell，平空仓为 buy
+	Sz              int64  // 委托张数，0 表示全部平仓
+	ReduceOnly      bool   // 是否只减仓
+	PosSide         string // 双向持仓模式下全部平仓时的持仓方向 long/short
+	TpTriggerPx     string // 止盈触发价，为空则不下止盈单
+	TpTriggerPxType string // 止盈触发价类型 last/mark/index，默认 last
+	TpOrdPx         string // 止盈委托价，为空或 -1 时以市价委托
+	SlTriggerPx     string // 止损触发价，为空则不下止损单
+	SlTriggerPxType string // 止损触发价类型 last/mark/index，默认 last
+	SlOrdPx         string // 止损委托价，为空或 -1 时以市价委托
+	Expiration      int32  // 触发条件最长等待时间（秒），0 使用默认值
+	Text            string // 订单备注
+}
+
+// GateTriggerOrderResult 止盈止损下单结果，Gate 的价格触发单只有一个触发条件，止盈和止损会分别生成一笔订单
+type GateTriggerOrderResult struct {
+	TpOrderId int64 // 止盈单ID，未下止盈单时为 0
+	SlOrderId int64 // 止损单ID，未下止损单时为 0
+}
+
+// GateAccount Gate 账户类型，用于钱包内划转
+type GateAccount string
+
+const (
+	GateAccountSpot     GateAccount = "spot"     // 现货账户
+	GateAccountMargin   GateAccount = "margin"   // 逐仓杠杆账户
+	GateAccountFutures  GateAccount = "futures"  // 永续合约账户
+	GateAccountDelivery GateAccount = "delivery" // 交割合约账户
+	GateAccountOptions  GateAccount = "options"  // 期权账户
+)
+
+// GateTransferRequest 钱包内账户划转请求
+type GateTransferRequest struct {
+	Ccy          string      // 划转币种，如 USDT
+	Amt          string      // 划转数量
+	From         GateAccount // 转出账户
+	To           GateAccount // 转入账户
+	CurrencyPair string      // 杠杆交易对，转入或转出杠杆账户时必填
+	Settle       string      // 合约结算货币，转入或转出合约账户时必填，为空时默认 usdt
+}
+
+// GateWsMarket Gate WebSocket 市场类型，现货和合约使用不同的连接地址
+type GateWsMarket string
+
+const (
+	GateWsMarketSpot    GateWsMarket = "spot"    // 现货
+	GateWsMarketFutures GateWsMarket = "futures" // USDT 永续合约
+)
+
+// gateWsFloat 兼容 Gate WebSocket 中以字符串或数字返回的数值字段
+type gateWsFloat float64
+
+func (f *gateWsFloat) UnmarshalJSON(data []byte) error {
+	value := strings.Trim(string(data), `"`)
+	if value == "" || value == "null" {
+		*f = 0
+		return nil
+	}
+	valueFloat, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("解析数值失败: %v", err)
+	}
+	*f = gateWsFloat(valueFloat)
+	return nil
+}
+
+// gateWsRequest WebSocket 请求
+type gateWsRequest struct {
+	Time    int64       `json:"time"`
+	Channel string      `json:"channel"`
+	Event   string      `json:"event,omitempty"`
+	Payload []string    `json:"payload,omitempty"`
+	Auth    *gateWsAuth `json:"auth,omitempty"`
+}
+
+// gateWsAuth WebSocket 私有频道签名
+type gateWsAuth struct {
+	Method string `json:"method"`
+	Key    string `json:"KEY"`
+	Sign   string `json:"SIGN"`
+}
+
+// gateWsResponse WebSocket 推送消息
+type gateWsResponse struct {
+	Time    int64           `json:"time"`
+	Channel string          `json:"channel"`
+	Event   string          `json:"event"`
+	Error   *gateWsError    `json:"error"`
+	Result  json.RawMessage `json:"result"`
+}
+
+// gateWsError WebSocket 错误信息
+type gateWsError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// GateWsTicker 行情推送
+type GateWsTicker struct {
+	InstId string  // 交易对或合约
+	Last   float64 // 最新成交价
+	BidPx  float64 // 买一价
+	AskPx  float64 // 卖一价
+	High24 float64 // 24小时最高价
+	Low24  float64 // 24小时最低价
+	Vol24  float64 // 24小时成交量
+	Ts     int64   // 推送时间，Unix 毫秒时间戳
+}
+
+// gateWsTickerData 现货和合约行情推送的原始数据
+type gateWsTickerData struct {
+	CurrencyPair string      `json:"currency_pair"`
+	Contract     string      `json:"contract"`
+	Last         gateWsFloat `json:"last"`
+	HighestBid   gateWsFloat `json:"highest_bid"`
+	LowestAsk    gateWsFloat `json:"lowest_ask"`
+	High24h      gateWsFloat `json:"high_24h"`
+	Low24h       gateWsFloat `json:"low_24h"`
+	BaseVolume   gateWsFloat `json:"base_volume"`
+	Volume24h    gateWsFloat `json:"volume_24h"`
+}
+
+// GateWsCandle K线推送
+type GateWsCandle struct {
+	InstId   string // 交易对或合约
+	Interval string // K线周期
+	Candle
+}
+
+// gateWsCandleData 现货和合约K线推送的原始数据
+type gateWsCandleData struct {
+	T gateWsFloat `json:"t"`
+	V gateWsFloat `json:"v"`
+	C gateWsFloat `json:"c"`
+	H gateWsFloat `json:"h"`
+	L gateWsFloat `json:"l"`
+	O gateWsFloat `json:"o"`
+	N string      `json:"n"` // 周期_交易对，如 1m_BTC_USDT
+	A gateWsFloat `json:"a"`
+	W bool        `json:"w"` // K线是否已完结，仅现货返回
+}
+
+// gateWsOrderBookData 现货和合约深度推送的原始数据
+type gateWsOrderBookData struct {
+	T        int64             `json:"t"`
+	S        string            `json:"s"`
+	Contract string            `json:"contract"`
+	Asks     []json.RawMessage `json:"asks"`
+	Bids     []json.RawMessage `json:"bids"`
+}
+
+// GateWsSpotOrder 现货订单推送
+type GateWsSpotOrder struct {
+	Id           string      `json:"id"`             // 订单ID
+	Text         string      `json:"text"`           // 用户自定义信息
+	CurrencyPair string      `json:"currency_pair"`  // 交易对
+	Type         string      `json:"type"`           // 订单类型 limit/market
+	Account      string      `json:"account"`        // 账户类型
+	Side         string      `json:"side"`           // 买卖方向
+	Amount       gateWsFloat `json:"amount"`         // 委托数量
+	Price        gateWsFloat `json:"price"`          // 委托价格
+	Left         gateWsFloat `json:"left"`           // 未成交数量
+	FilledTotal  gateWsFloat `json:"filled_total"`   // 已成交总额
+	AvgDealPrice gateWsFloat `json:"avg_deal_price"` // 成交均价
+	Fee          gateWsFloat `json:"fee"`            // 手续费
+	FeeCurrency  string      `json:"fee_currency"`   // 手续费币种
+	Event        string      `json:"event"`          // 订单事件 put/update/finish
+	FinishAs     string      `json:"finish_as"`      // 订单结束方式
+	UpdateTimeMs gateWsFloat `json:"update_time_ms"` // 更新时间，Unix 毫秒时间戳
+}
+
+// GateWsBalance 现货余额推送
+type GateWsBalance struct {
+	Currency    string      `json:"currency"`     // 币种
+	Change      gateWsFloat `json:"change"`       // 变动数量
+	Total       gateWsFloat `json:"total"`        // 总余额
+	Available   gateWsFloat `json:"available"`    // 可用余额
+	Freeze      gateWsFloat `json:"freeze"`       // 冻结余额
+	ChangeType  string      `json:"change_type"`  // 变动类型
+	TimestampMs gateWsFloat `json:"timestamp_ms"` // 变动时间，Unix 毫秒时间戳
+}
+
+// GateWsFutureOrder 合约订单推送
+type GateWsFutureOrder struct {
+	Id           int64       `json:"id"`             // 订单ID
+	Contract     string      `json:"contract"`       // 合约
+	Size         int64       `json:"size"`           // 委托张数，正数买入，负数卖出
+	Left         int64       `json:"left"`           // 未成交张数
+	Price        gateWsFloat `json:"price"`          // 委托价格
+	FillPrice    gateWsFloat `json:"fill_price"`     // 成交均价
+	Status       string      `json:"status"`         // 订单状态 open/finished
+	FinishAs     string      `json:"finish_as"`      // 订单结束方式
+	IsReduceOnly bool        `json:"is_reduce_only"` // 是否只减仓
+	IsClose      bool        `json:"is_close"`       // 是否为平仓单
+	Text         string      `json:"text"`           // 用户自定义信息
+	Tif          string      `json:"tif"`            // 有效方式
+	CreateTimeMs gateWsFloat `json:"create_time_ms"` // 创建时间，Unix 毫秒时间戳
+	FinishTimeMs gateWsFloat `json:"finish_time_ms"` // 结束时间，Unix 毫秒时间戳
+}
+
+// GateWsPosition 合约仓位推送
+type GateWsPosition struct {
+	Contract    string      `json:"contract"`     // 合约
+	Size        int64       `json:"size"`         // 持仓张数，正数多仓，负数空仓
+	EntryPrice  gateWsFloat `json:"entry_price"`  // 开仓均价
+	Leverage    gateWsFloat `json:"leverage"`     // 杠杆倍数，0 为全仓
+	LiqPrice    gateWsFloat `json:"liq_price"`    // 强平价格
+	Margin      gateWsFloat `json:"margin"`       // 保证金
+	Mode        string      `json:"mode"`         // 持仓模式 single/dual_long/dual_short
+	RealisedPnl gateWsFloat `json:"realised_pnl"` // 已实现盈亏
+	TimeMs      gateWsFloat `json:"time_ms"`      // 更新时间，Unix 毫秒时间戳
+}
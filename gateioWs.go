@@ -0,0 +1,535 @@
+package galatvtr
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	GateWsSpotURL           = "wss://api.gateio.ws/ws/v4/"
+	GateWsSpotTestnetURL    = "wss://ws-testnet.gate.com/v4/ws/spot"
+	GateWsFuturesURL        = "wss://fx-ws.gateio.ws/v4/ws/usdt"
+	GateWsFuturesTestnetURL = "wss://fx-ws-testnet.gateio.ws/v4/ws/usdt"
+
+	// 心跳间隔，服务端超过 60 秒未收到消息会断开连接
+	gateWsPingInterval = 10 * time.Second
+	// 读超时，超过该时间未收到任何消息视为连接已断开
+	gateWsReadTimeout = 30 * time.Second
+	// 断线重连的最大等待时间
+	gateWsMaxReconnectWait = 30 * time.Second
+	// 事件通道缓冲大小
+	gateWsChannelSize = 1024
+)
+
+// gateWsSubscription 已订阅的频道，断线重连后自动重新订阅
+type gateWsSubscription struct {
+	channel string
+	payload []string
+	private bool
+}
+
+// GateWsClient Gate WebSocket v4 客户端，一个客户端对应一个市场（现货或合约）的连接，
+// 订阅的数据通过对应的事件通道推送，调用 Run 后自动维持心跳和断线重连
+type GateWsClient struct {
+	Tickers      chan GateWsTicker      // 行情推送
+	Candles      chan GateWsCandle      // K线推送
+	OrderBooks   chan OrderBook         // 深度推送
+	SpotOrders   chan GateWsSpotOrder   // 现货订单推送
+	Balances     chan GateWsBalance     // 现货余额推送
+	FutureOrders chan GateWsFutureOrder // 合约订单推送
+	Positions    chan GateWsPosition    // 合约仓位推送
+	Errors       chan error             // 连接和订阅错误，缓冲满时丢弃
+
+	market    GateWsMarket
+	url       string
+	apiKey    string
+	secretKey string
+	userId    string
+
+	mu   sync.Mutex
+	conn *websocket.Conn
+	subs []gateWsSubscription
+}
+
+// NewGateWsClient 创建带认证的 WebSocket 客户端，userId 仅订阅合约私有频道时需要，可通过 GateIOClient.GetUserId 获取
+func NewGateWsClient(market GateWsMarket, apiKey, secretKey string, userId int64, isTestnet bool) *GateWsClient {
+	c := NewGateWsClientWithoutAuth(market, isTestnet)
+	c.apiKey = apiKey
+	c.secretKey = secretKey
+	if userId > 0 {
+		c.userId = strconv.FormatInt(userId, 10)
+	}
+	return c
+}
+
+// NewGateWsClientWithoutAuth 创建只能订阅公共频道的 WebSocket 客户端
+func NewGateWsClientWithoutAuth(market GateWsMarket, isTestnet bool) *GateWsClient {
+	url := GateWsSpotURL
+	switch {
+	case market == GateWsMarketSpot && isTestnet:
+		url = GateWsSpotTestnetURL
+	case market == GateWsMarketFutures && isTestnet:
+		url = GateWsFuturesTestnetURL
+	case market == GateWsMarketFutures:
+		url = GateWsFuturesURL
+	}
+	return &GateWsClient{
+		Tickers:      make(chan GateWsTicker, gateWsChannelSize),
+		Candles:      make(chan GateWsCandle, gateWsChannelSize),
+		OrderBooks:   make(chan OrderBook, gateWsChannelSize),
+		SpotOrders:   make(chan GateWsSpotOrder, gateWsChannelSize),
+		Balances:     make(chan GateWsBalance, gateWsChannelSize),
+		FutureOrders: make(chan GateWsFutureOrder, gateWsChannelSize),
+		Positions:    make(chan GateWsPosition, gateWsChannelSize),
+		Errors:       make(chan error, gateWsChannelSize),
+		market:       market,
+		url:          url,
+	}
+}
+
+// SubscribeTickers 订阅行情，支持 TradingView 格式或 Gate 格式的交易对
+func (c *GateWsClient) SubscribeTickers(instIds ...string) error {
+	payload, err := gateWsInstIds(instIds)
+	if err != nil {
+		return err
+	}
+	return c.subscribe(gateWsSubscription{channel: c.channel("tickers"), payload: payload})
+}
+
+// SubscribeCandles 订阅K线，interval 如 1m/5m/1h
+func (c *GateWsClient) SubscribeCandles(interval, instId string) error {
+	if _, err := gateIntervalSeconds(interval); err != nil {
+		return err
+	}
+	pair, err := normalizeGateioInstId(instId)
+	if err != nil {
+		return err
+	}
+	return c.subscribe(gateWsSubscription{channel: c.channel("candlesticks"), payload: []string{interval, pair}})
+}
+
+// SubscribeOrderBook 订阅深度快照，depth 为档位数量，现货支持 5/10/20/50/100，合约支持 1/5/10/20/50/100
+func (c *GateWsClient) SubscribeOrderBook(instId string, depth int) error {
+	pair, err := normalizeGateioInstId(instId)
+	if err != nil {
+		return err
+	}
+	// 现货第三个参数为推送频率，合约为价格合并精度，0 表示不合并
+	third := "100ms"
+	if c.market == GateWsMarketFutures {
+		third = "0"
+	}
+	return c.subscribe(gateWsSubscription{channel: c.channel("order_book"), payload: []string{pair, strconv.Itoa(depth), third}})
+}
+
+// SubscribeSpotOrders 订阅现货订单更新，不传交易对时订阅全部
+func (c *GateWsClient) SubscribeSpotOrders(instIds ...string) error {
+	if c.market != GateWsMarketSpot {
+		return fmt.Errorf("spot.orders 仅支持现货连接")
+	}
+	payload := []string{"!all"}
+	if len(instIds) > 0 {
+		var err error
+		if payload, err = gateWsInstIds(instIds); err != nil {
+			return err
+		}
+	}
+	return c.subscribe(gateWsSubscription{channel: "spot.orders", payload: payload, private: true})
+}
+
+// SubscribeSpotBalances 订阅现货余额变动
+func (c *GateWsClient) SubscribeSpotBalances() error {
+	if c.market != GateWsMarketSpot {
+		return fmt.Errorf("spot.balances 仅支持现货连接")
+	}
+	return c.subscribe(gateWsSubscription{channel: "spot.balances", private: true})
+}
+
+// SubscribeFutureOrders 订阅合约订单更新，不传合约时订阅全部
+func (c *GateWsClient) SubscribeFutureOrders(contracts ...string) error {
+	return c.subscribeFuturesPrivate("futures.orders", contracts)
+}
+
+// SubscribeFuturePositions 订阅合约仓位更新，不传合约时订阅全部
+func (c *GateWsClient) SubscribeFuturePositions(contracts ...string) error {
+	return c.subscribeFuturesPrivate("futures.positions", contracts)
+}
+
+func (c *GateWsClient) subscribeFuturesPrivate(channel string, contracts []string) error {
+	if c.market != GateWsMarketFutures {
+		return fmt.Errorf("%s 仅支持合约连接", channel)
+	}
+	if c.userId == "" {
+		return fmt.Errorf("订阅 %s 需要提供 userId", channel)
+	}
+	if len(contracts) == 0 {
+		return c.subscribe(gateWsSubscription{channel: channel, payload: []string{c.userId, "!all"}, private: true})
+	}
+	instIds, err := gateWsInstIds(contracts)
+	if err != nil {
+		return err
+	}
+	for _, instId := range instIds {
+		if err := c.subscribe(gateWsSubscription{channel: channel, payload: []string{c.userId, instId}, private: true}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *GateWsClient) subscribe(sub gateWsSubscription) error {
+	if sub.private && (c.apiKey == "" || c.secretKey == "") {
+		return fmt.Errorf("订阅私有频道 %s 需要提供 apiKey 和 secretKey", sub.channel)
+	}
+
+	c.mu.Lock()
+	c.subs = append(c.subs, sub)
+	conn := c.conn
+	c.mu.Unlock()
+
+	// 未连接时只记录订阅，连接建立后统一发送
+	if conn == nil {
+		return nil
+	}
+	return c.send(conn, sub, "subscribe")
+}
+
+// Run 建立连接并持续读取推送，断线后按指数退避自动重连并重新订阅，直到 ctx 结束
+func (c *GateWsClient) Run(ctx context.Context) error {
+	wait := time.Second
+	for {
+		start := time.Now()
+		err := c.serve(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		c.reportError(fmt.Errorf("Gate WebSocket 连接断开: %v", err))
+
+		// 连接稳定运行过一段时间后重置退避时间
+		if time.Since(start) > time.Minute {
+			wait = time.Second
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		wait *= 2
+		if wait > gateWsMaxReconnectWait {
+			wait = gateWsMaxReconnectWait
+		}
+	}
+}
+
+// Close 关闭当前连接，Run 会在 ctx 未结束时自动重连
+func (c *GateWsClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+func (c *GateWsClient) serve(ctx context.Context) error {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, c.url, nil)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.conn = conn
+	subs := append([]gateWsSubscription(nil), c.subs...)
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		c.conn = nil
+		c.mu.Unlock()
+		conn.Close()
+	}()
+
+	for _, sub := range subs {
+		if err := c.send(conn, sub, "subscribe"); err != nil {
+			return err
+		}
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go c.keepalive(ctx, conn, done)
+
+	for {
+		conn.SetReadDeadline(time.Now().Add(gateWsReadTimeout))
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+		if err := c.handle(ctx, message); err != nil {
+			c.reportError(err)
+		}
+	}
+}
+
+// keepalive 定时发送心跳，ctx 结束时关闭连接以中断读取
+func (c *GateWsClient) keepalive(ctx context.Context, conn *websocket.Conn, done chan struct{}) {
+	ticker := time.NewTicker(gateWsPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			conn.Close()
+			return
+		case <-ticker.C:
+			if err := c.writeJSON(conn, gateWsRequest{Time: time.Now().Unix(), Channel: c.channel("ping")}); err != nil {
+				conn.Close()
+				return
+			}
+		}
+	}
+}
+
+func (c *GateWsClient) send(conn *websocket.Conn, sub gateWsSubscription, event string) error {
+	request := gateWsRequest{
+		Time:    time.Now().Unix(),
+		Channel: sub.channel,
+		Event:   event,
+		Payload: sub.payload,
+	}
+	if sub.private {
+		request.Auth = &gateWsAuth{
+			Method: "api_key",
+			Key:    c.apiKey,
+			Sign:   c.sign(sub.channel, event, request.Time),
+		}
+	}
+	return c.writeJSON(conn, request)
+}
+
+// writeJSON 串行写入，gorilla/websocket 不支持并发写
+func (c *GateWsClient) writeJSON(conn *websocket.Conn, v interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	conn.SetWriteDeadline(time.Now().Add(gateWsReadTimeout))
+	return conn.WriteJSON(v)
+}
+
+// 生成 Gate WebSocket 私有频道签名
+func (c *GateWsClient) sign(channel, event string, timestamp int64) string {
+	message := fmt.Sprintf("channel=%s&event=%s&time=%d", channel, event, timestamp)
+	mac := hmac.New(sha512.New, []byte(c.secretKey))
+	mac.Write([]byte(message))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (c *GateWsClient) channel(name string) string {
+	return string(c.market) + "." + name
+}
+
+func (c *GateWsClient) reportError(err error) {
+	select {
+	case c.Errors <- err:
+	default:
+	}
+}
+
+func (c *GateWsClient) handle(ctx context.Context, message []byte) error {
+	var response gateWsResponse
+	if err := json.Unmarshal(message, &response); err != nil {
+		return fmt.Errorf("解析推送消息失败: %v", err)
+	}
+	if response.Error != nil {
+		return fmt.Errorf("%s 返回错误: %d %s", response.Channel, response.Error.Code, response.Error.Message)
+	}
+	if response.Event != "update" && response.Event != "all" {
+		return nil
+	}
+
+	switch strings.TrimPrefix(response.Channel, string(c.market)+".") {
+	case "tickers":
+		var items []gateWsTickerData
+		if err := gateWsDecodeList(response.Result, &items); err != nil {
+			return err
+		}
+		for _, item := range items {
+			ticker := GateWsTicker{
+				InstId: item.CurrencyPair + item.Contract,
+				Last:   float64(item.Last),
+				BidPx:  float64(item.HighestBid),
+				AskPx:  float64(item.LowestAsk),
+				High24: float64(item.High24h),
+				Low24:  float64(item.Low24h),
+				Vol24:  float64(item.BaseVolume + item.Volume24h),
+				Ts:     response.Time * 1000,
+			}
+			if !gateWsEmit(ctx, c.Tickers, ticker) {
+				return nil
+			}
+		}
+	case "candlesticks":
+		var items []gateWsCandleData
+		if err := gateWsDecodeList(response.Result, &items); err != nil {
+			return err
+		}
+		for _, item := range items {
+			interval, instId, _ := strings.Cut(item.N, "_")
+			candle := GateWsCandle{
+				InstId:   instId,
+				Interval: interval,
+				Candle: Candle{
+					Ts:       int64(item.T) * 1000,
+					Open:     float64(item.O),
+					High:     float64(item.H),
+					Low:      float64(item.L),
+					Close:    float64(item.C),
+					Vol:      float64(item.A),
+					VolQuote: float64(item.V),
+					Confirm:  item.W,
+				},
+			}
+			// 合约K线的 v 为张数，不返回成交额
+			if c.market == GateWsMarketFutures {
+				candle.Vol, candle.VolQuote = float64(item.V), 0
+			}
+			if !gateWsEmit(ctx, c.Candles, candle) {
+				return nil
+			}
+		}
+	case "order_book":
+		var data gateWsOrderBookData
+		if err := json.Unmarshal(response.Result, &data); err != nil {
+			return fmt.Errorf("解析深度推送失败: %v", err)
+		}
+		book := OrderBook{InstId: data.S + data.Contract, Ts: data.T}
+		var err error
+		if book.Asks, err = gateWsParseLevels(data.Asks); err != nil {
+			return err
+		}
+		if book.Bids, err = gateWsParseLevels(data.Bids); err != nil {
+			return err
+		}
+		gateWsEmit(ctx, c.OrderBooks, book)
+	case "orders":
+		if c.market == GateWsMarketSpot {
+			var items []GateWsSpotOrder
+			if err := gateWsDecodeList(response.Result, &items); err != nil {
+				return err
+			}
+			for _, item := range items {
+				if !gateWsEmit(ctx, c.SpotOrders, item) {
+					return nil
+				}
+			}
+		} else {
+			var items []GateWsFutureOrder
+			if err := gateWsDecodeList(response.Result, &items); err != nil {
+				return err
+			}
+			for _, item := range items {
+				if !gateWsEmit(ctx, c.FutureOrders, item) {
+					return nil
+				}
+			}
+		}
+	case "balances":
+		var items []GateWsBalance
+		if err := gateWsDecodeList(response.Result, &items); err != nil {
+			return err
+		}
+		for _, item := range items {
+			if !gateWsEmit(ctx, c.Balances, item) {
+				return nil
+			}
+		}
+	case "positions":
+		var items []GateWsPosition
+		if err := gateWsDecodeList(response.Result, &items); err != nil {
+			return err
+		}
+		for _, item := range items {
+			if !gateWsEmit(ctx, c.Positions, item) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// gateWsEmit 推送事件，ctx 结束时返回 false
+func gateWsEmit[T any](ctx context.Context, ch chan T, v T) bool {
+	select {
+	case ch <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// gateWsDecodeList 解析推送结果，兼容单个对象和数组两种格式
+func gateWsDecodeList[T any](raw json.RawMessage, items *[]T) error {
+	trimmed := strings.TrimSpace(string(raw))
+	if strings.HasPrefix(trimmed, "[") {
+		if err := json.Unmarshal(raw, items); err != nil {
+			return fmt.Errorf("解析推送数据失败: %v", err)
+		}
+		return nil
+	}
+	var item T
+	if err := json.Unmarshal(raw, &item); err != nil {
+		return fmt.Errorf("解析推送数据失败: %v", err)
+	}
+	*items = append(*items, item)
+	return nil
+}
+
+// gateWsParseLevels 解析深度档位，现货为 [价格, 数量]，合约为 {"p": 价格, "s": 数量}
+func gateWsParseLevels(rows []json.RawMessage) ([]OrderBookLevel, error) {
+	levels := make([]OrderBookLevel, 0, len(rows))
+	for _, row := range rows {
+		if strings.HasPrefix(strings.TrimSpace(string(row)), "[") {
+			var pair []gateWsFloat
+			if err := json.Unmarshal(row, &pair); err != nil || len(pair) < 2 {
+				return nil, fmt.Errorf("深度数据格式错误: %s", string(row))
+			}
+			levels = append(levels, OrderBookLevel{Px: float64(pair[0]), Sz: float64(pair[1])})
+			continue
+		}
+		var item struct {
+			P gateWsFloat `json:"p"`
+			S gateWsFloat `json:"s"`
+		}
+		if err := json.Unmarshal(row, &item); err != nil {
+			return nil, fmt.Errorf("深度数据格式错误: %s", string(row))
+		}
+		levels = append(levels, OrderBookLevel{Px: float64(item.P), Sz: float64(item.S)})
+	}
+	return levels, nil
+}
+
+// gateWsInstIds 批量转换交易对格式
+func gateWsInstIds(instIds []string) ([]string, error) {
+	if len(instIds) == 0 {
+		return nil, fmt.Errorf("至少需要提供一个交易对")
+	}
+	result := make([]string, 0, len(instIds))
+	for _, instId := range instIds {
+		pair, err := normalizeGateioInstId(instId)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, pair)
+	}
+	return result, nil
+}
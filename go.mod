@@ -5,4 +5,5 @@ go 1.21.6
 require (
 	github.com/antihax/optional v1.0.0
 	github.com/gateio/gateapi-go/v6 v6.104.3
+	github.com/gorilla/websocket v1.5.3
 )
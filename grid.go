@@ -0,0 +1,440 @@
+package galatvtr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// GridMode 网格价格的分布方式
+type GridMode string
+
+const (
+	GridArithmetic GridMode = "arithmetic" // 等差网格，相邻档位价差相同
+	GridGeometric  GridMode = "geometric"  // 等比网格，相邻档位涨幅相同
+)
+
+const defaultGridInterval = 5 * time.Second
+
+// GridConfig 网格参数
+type GridConfig struct {
+	InstId string   // 产品ID
+	Lower  float64  // 网格下限价格
+	Upper  float64  // 网格上限价格
+	Grids  int      // 网格数量，价格档位为 Grids+1 个
+	Mode   GridMode // 价格分布方式，默认等差
+	Sz     float64  // 每格下单数量，现货为交易货币数量，合约为张数
+}
+
+// GridSlot 网格的一个价格档位，每个档位最多挂一笔订单
+type GridSlot struct {
+	Px      float64 // 档位价格
+	Side    string  // 该档位的挂单方向 buy/sell，为空表示没有挂单
+	Sz      float64 // 挂单数量
+	OrderId string  // 交易所订单ID，暂停或尚未下单时为空
+	Counter bool    // 是否为平掉此前开仓成交的反向单，成交时计入网格利润
+	EntryPx float64 // 平仓反向单对应的开仓成交价格，用于计算网格利润
+}
+
+// GridState 网格状态，每次变化后写入状态文件
+type GridState struct {
+	Config     GridConfig
+	Instrument ExecInstrument
+	Slots      []GridSlot // 按价格升序
+	Started    bool       // 是否已铺设初始挂单
+	Paused     bool       // 是否已暂停，暂停时撤销全部挂单但保留各档位的方向和数量
+	Profit     float64    // 已实现网格利润（计价货币，不含手续费）
+	Trades     int        // 已完成的买卖配对次数
+	Position   float64    // 网格累计净买入数量，负数表示净卖出
+	UpdatedAt  time.Time  // 最近一次更新时间
+}
+
+// GridBot 本地网格机器人，在 Lower 和 Upper 之间挂出限价单阶梯，
+// 某档成交后在相邻档位挂出反向单，通过 ExecVenue 同时支持 OKX 和 Gate 的现货与合约。
+// 现货的卖单需要账户中已有交易货币；合约使用单向持仓，卖单即开空。
+// 每次变化后将状态写入 path，重启后通过 NewGridBot 恢复，Pause/Resume 暂停和恢复挂单
+type GridBot struct {
+	Interval time.Duration // Run 的轮询间隔，默认 5 秒
+
+	venue ExecVenue
+	path  string
+	mu    sync.Mutex
+	state GridState
+}
+
+// NewGridBot 创建网格机器人，path 存在时从中恢复状态并忽略 config 中的价格参数
+func NewGridBot(venue ExecVenue, config GridConfig, path string) (*GridBot, error) {
+	b := &GridBot{Interval: defaultGridInterval, venue: venue, path: path}
+
+	data, err := os.ReadFile(path)
+	if err == nil {
+		if err := json.Unmarshal(data, &b.state); err != nil {
+			return nil, fmt.Errorf("解析网格状态文件失败: %v", err)
+		}
+		if b.state.Config.InstId != config.InstId {
+			return nil, fmt.Errorf("网格状态文件中的产品 %s 与配置 %s 不一致", b.state.Config.InstId, config.InstId)
+		}
+		return b, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	if config.Lower <= 0 || config.Upper <= config.Lower {
+		return nil, fmt.Errorf("网格价格区间无效: %v - %v", config.Lower, config.Upper)
+	}
+	if config.Grids < 2 {
+		return nil, fmt.Errorf("网格数量至少为 2")
+	}
+	if config.Mode == "" {
+		config.Mode = GridArithmetic
+	}
+	if config.Mode != GridArithmetic && config.Mode != GridGeometric {
+		return nil, fmt.Errorf("不支持的网格类型: %s", config.Mode)
+	}
+
+	instrument, err := venue.Instrument(config.InstId)
+	if err != nil {
+		return nil, err
+	}
+	if instrument.CtVal <= 0 {
+		instrument.CtVal = 1
+	}
+	sz := instrument.roundSz(config.Sz)
+	if sz <= 0 {
+		return nil, fmt.Errorf("每格数量 %v 低于最小下单数量 %v", config.Sz, instrument.MinSz)
+	}
+	config.Sz = sz
+
+	b.state = GridState{Config: config, Instrument: instrument, Slots: gridSlots(config, instrument.TickSz)}
+	for i := 1; i < len(b.state.Slots); i++ {
+		if b.state.Slots[i].Px <= b.state.Slots[i-1].Px {
+			return nil, fmt.Errorf("网格间距小于价格精度 %v", instrument.TickSz)
+		}
+	}
+	return b, nil
+}
+
+// gridSlots 按网格类型计算各档位价格
+func gridSlots(config GridConfig, tickSz float64) []GridSlot {
+	slots := make([]GridSlot, config.Grids+1)
+	for i := range slots {
+		var px float64
+		if config.Mode == GridGeometric {
+			px = config.Lower * math.Pow(config.Upper/config.Lower, float64(i)/float64(config.Grids))
+		} else {
+			px = config.Lower + (config.Upper-config.Lower)*float64(i)/float64(config.Grids)
+		}
+		slots[i].Px = roundTick(px, tickSz)
+	}
+	return slots
+}
+
+// roundTick 按价格精度四舍五入，并去掉浮点误差
+func roundTick(px, tickSz float64) float64 {
+	if tickSz <= 0 {
+		return px
+	}
+	decimals := int(math.Max(0, math.Ceil(-math.Log10(tickSz)-1e-9)))
+	px, _ = strconv.ParseFloat(strconv.FormatFloat(math.Round(px/tickSz)*tickSz, 'f', decimals, 64), 64)
+	return px
+}
+
+// State 返回网格状态的副本
+func (b *GridBot) State() GridState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	state := b.state
+	state.Slots = append([]GridSlot(nil), b.state.Slots...)
+	return state
+}
+
+// Start 按最新价格铺设初始挂单：低于最新价的档位挂买单，高于最新价的档位挂卖单，
+// 最接近最新价的档位留空。已启动时不做任何操作
+func (b *GridBot) Start() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state.Started {
+		return nil
+	}
+
+	last, err := b.venue.LastPrice(b.state.Config.InstId)
+	if err != nil {
+		return err
+	}
+	if last <= b.state.Slots[0].Px || last >= b.state.Slots[len(b.state.Slots)-1].Px {
+		return fmt.Errorf("最新价格 %v 不在网格区间 %v - %v 内", last, b.state.Config.Lower, b.state.Config.Upper)
+	}
+
+	empty := 0
+	for i, slot := range b.state.Slots {
+		if math.Abs(slot.Px-last) < math.Abs(b.state.Slots[empty].Px-last) {
+			empty = i
+		}
+	}
+	for i := range b.state.Slots {
+		slot := &b.state.Slots[i]
+		switch {
+		case i == empty:
+			continue
+		case slot.Px < last:
+			slot.Side = "buy"
+		default:
+			slot.Side = "sell"
+		}
+		slot.Sz = b.state.Config.Sz
+	}
+	b.state.Started = true
+	fmt.Printf("[Grid] %s 启动网格，最新价格 %v，%d 个档位\n", b.state.Config.InstId, last, len(b.state.Slots))
+
+	err = b.placePending()
+	if saveErr := b.save(); saveErr != nil {
+		fmt.Printf("[Grid] 保存网格状态失败: %v\n", saveErr)
+	}
+	return err
+}
+
+// Pause 撤销全部挂单并保存状态，撤单前已成交的部分照常补挂反向档位
+func (b *GridBot) Pause() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state.Paused {
+		return nil
+	}
+	b.state.Paused = true
+
+	var errs []error
+	var fills []gridFill
+	for i := range b.state.Slots {
+		slot := &b.state.Slots[i]
+		if slot.OrderId == "" {
+			continue
+		}
+		if err := b.venue.CancelChild(b.state.Config.InstId, slot.OrderId); err != nil {
+			fmt.Printf("[Grid] 撤销订单 %s 失败: %v\n", slot.OrderId, err)
+		}
+		status, err := b.waitDone(slot.OrderId)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("订单 %s 撤销后状态未知: %v", slot.OrderId, err))
+			continue
+		}
+		slot.OrderId = ""
+		if status.Filled > 0 {
+			fills = append(fills, b.takeFill(i, status.Filled))
+		}
+	}
+	b.applyFills(fills)
+	fmt.Printf("[Grid] %s 已暂停\n", b.state.Config.InstId)
+
+	if err := b.save(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Resume 按暂停时保存的档位重新挂单
+func (b *GridBot) Resume() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.state.Paused {
+		return nil
+	}
+	b.state.Paused = false
+	fmt.Printf("[Grid] %s 恢复运行\n", b.state.Config.InstId)
+
+	err := b.placePending()
+	if saveErr := b.save(); saveErr != nil {
+		fmt.Printf("[Grid] 保存网格状态失败: %v\n", saveErr)
+	}
+	return err
+}
+
+// Run 按 Interval 轮询挂单状态直到 ctx 结束，退出时挂单保留在交易所
+func (b *GridBot) Run(ctx context.Context) error {
+	if err := b.Start(); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(b.Interval)
+	defer ticker.Stop()
+	for {
+		if err := b.Poll(); err != nil {
+			fmt.Printf("[Grid] %s 轮询失败: %v\n", b.state.Config.InstId, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll 查询全部挂单，处理已结束的订单并补挂反向单。订单被外部撤销时只按已成交部分补挂
+func (b *GridBot) Poll() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.state.Started || b.state.Paused {
+		return nil
+	}
+
+	var fills []gridFill
+	for i := range b.state.Slots {
+		slot := &b.state.Slots[i]
+		if slot.OrderId == "" {
+			continue
+		}
+		status, err := b.venue.ChildStatus(b.state.Config.InstId, slot.OrderId)
+		if err != nil {
+			fmt.Printf("[Grid] 查询订单 %s 失败: %v\n", slot.OrderId, err)
+			continue
+		}
+		if !status.Done {
+			continue
+		}
+		if status.Filled < slot.Sz {
+			fmt.Printf("[Grid] 订单 %s 已结束但未完全成交: %v/%v\n", slot.OrderId, status.Filled, slot.Sz)
+		}
+		slot.OrderId = ""
+		fills = append(fills, b.takeFill(i, status.Filled))
+	}
+	// 先清空全部成交档位再补挂，同一轮中连续成交的相邻档位才不会互相冲突
+	b.applyFills(fills)
+
+	err := b.placePending()
+	if len(fills) > 0 {
+		if saveErr := b.save(); saveErr != nil {
+			fmt.Printf("[Grid] 保存网格状态失败: %v\n", saveErr)
+		}
+	}
+	return err
+}
+
+// gridFill 一个档位的成交，补挂到 Target 档位
+type gridFill struct {
+	Index   int
+	Target  int
+	Side    string // 反向单方向
+	Sz      float64
+	Counter bool    // 补挂的是否为平仓反向单，仅开仓成交后补挂的订单才是
+	EntryPx float64 // 平仓反向单的开仓价格，即本次成交价格
+}
+
+// takeFill 清空成交档位并计入持仓和利润，返回需要补挂的反向单
+func (b *GridBot) takeFill(index int, filled float64) gridFill {
+	slot := &b.state.Slots[index]
+	side, counter, entryPx := slot.Side, slot.Counter, slot.EntryPx
+	*slot = GridSlot{Px: slot.Px}
+
+	fill := gridFill{Index: index, Sz: b.state.Instrument.roundSz(filled)}
+	if !counter {
+		fill.Counter, fill.EntryPx = true, slot.Px
+	}
+	if side == "buy" {
+		b.state.Position += filled
+		fill.Side, fill.Target = "sell", index+1
+	} else {
+		b.state.Position -= filled
+		fill.Side, fill.Target = "buy", index-1
+	}
+
+	// 利润在越界返回前计入，最高、最低档位的反向单成交同样需要记账
+	if counter && entryPx == 0 {
+		// 旧状态文件没有 EntryPx，按开仓档位的价格计算，开仓档位即本次补挂的目标档位
+		if fill.Target >= 0 && fill.Target < len(b.state.Slots) {
+			entryPx = b.state.Slots[fill.Target].Px
+		}
+	}
+	if counter && entryPx > 0 {
+		profit := (slot.Px - entryPx) * filled * b.state.Instrument.CtVal
+		if side == "buy" {
+			profit = -profit
+		}
+		b.state.Profit += profit
+		b.state.Trades++
+		fmt.Printf("[Grid] %s %s %v @ %v 成交，本次利润 %.8f，累计利润 %.8f\n",
+			b.state.Config.InstId, side, filled, slot.Px, profit, b.state.Profit)
+	} else {
+		fmt.Printf("[Grid] %s %s %v @ %v 成交\n", b.state.Config.InstId, side, filled, slot.Px)
+	}
+	if fill.Target < 0 || fill.Target >= len(b.state.Slots) {
+		fill.Sz = 0
+	}
+	return fill
+}
+
+func (b *GridBot) applyFills(fills []gridFill) {
+	for _, fill := range fills {
+		if fill.Sz <= 0 {
+			continue
+		}
+		target := &b.state.Slots[fill.Target]
+		if target.Side != "" {
+			fmt.Printf("[Grid] 档位 %v 已有 %s 挂单，无法补挂 %s %v\n", target.Px, target.Side, fill.Side, fill.Sz)
+			continue
+		}
+		target.Side, target.Sz, target.Counter, target.EntryPx = fill.Side, fill.Sz, fill.Counter, fill.EntryPx
+	}
+	b.state.UpdatedAt = time.Now()
+}
+
+// placePending 为有方向但没有订单的档位下限价单，暂停时不下单
+func (b *GridBot) placePending() error {
+	if b.state.Paused {
+		return nil
+	}
+	var errs []error
+	for i := range b.state.Slots {
+		slot := &b.state.Slots[i]
+		if slot.Side == "" || slot.OrderId != "" {
+			continue
+		}
+		orderId, err := b.venue.PlaceChild(ExecChildOrder{
+			InstId: b.state.Config.InstId,
+			Side:   slot.Side,
+			Sz:     slot.Sz,
+			Px:     slot.Px,
+		})
+		if err != nil {
+			// 保留方向和数量，下一轮轮询时重试
+			errs = append(errs, fmt.Errorf("档位 %v 下单失败: %v", slot.Px, err))
+			continue
+		}
+		slot.OrderId = orderId
+	}
+	b.state.UpdatedAt = time.Now()
+	return errors.Join(errs...)
+}
+
+// waitDone 等待撤销的订单结束
+func (b *GridBot) waitDone(orderId string) (ExecChildStatus, error) {
+	var status ExecChildStatus
+	var err error
+	for i := 0; i < defaultExecCancelPolls; i++ {
+		status, err = b.venue.ChildStatus(b.state.Config.InstId, orderId)
+		if err == nil && status.Done {
+			return status, nil
+		}
+		time.Sleep(defaultExecPoll)
+	}
+	if err == nil {
+		err = fmt.Errorf("订单未结束")
+	}
+	return status, err
+}
+
+func (b *GridBot) save() error {
+	data, err := json.MarshalIndent(b.state, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := b.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, b.path)
+}
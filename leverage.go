@@ -0,0 +1,80 @@
+package galatvtr
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// LeverageTarget 目标杠杆倍数
+type LeverageTarget struct {
+	InstId  string  // 产品ID，OKX 如 BTC-USDT-SWAP，Gate 如 BTC_USDT
+	MgnMode string  // 保证金模式 isolated：逐仓 cross：全仓
+	PosSide string  // 持仓方向，仅 OKX 开平仓模式下的逐仓需要，long 或 short
+	Lever   float64 // 杠杆倍数
+}
+
+// LeverageResult 杠杆设置结果
+type LeverageResult struct {
+	InstId   string  // 产品ID
+	MgnMode  string  // 保证金模式
+	PosSide  string  // 持仓方向
+	Previous float64 // 设置前的杠杆倍数
+	Current  float64 // 设置后的杠杆倍数
+	MaxLever float64 // 产品支持的最大杠杆倍数
+	Changed  bool    // 是否实际调用了设置接口
+}
+
+// LeverageManager 交易所无关的杠杆管理，OKXClient 和 GateIOClient 均实现该接口
+type LeverageManager interface {
+	// GetLeverage 查询当前杠杆倍数
+	GetLeverage(target LeverageTarget) (float64, error)
+	// EnsureLeverage 校验目标杠杆不超过产品的最大杠杆，与当前杠杆不同时才设置
+	EnsureLeverage(target LeverageTarget) (*LeverageResult, error)
+}
+
+var (
+	_ LeverageManager = (*OKXClient)(nil)
+	_ LeverageManager = (*GateIOClient)(nil)
+)
+
+// ensureLeverage 按 get/maxLever/set 执行 EnsureLeverage 的公共流程
+func ensureLeverage(target LeverageTarget, maxLever float64, get func() (float64, error), set func() error) (*LeverageResult, error) {
+	if target.Lever <= 0 {
+		return nil, fmt.Errorf("杠杆倍数必须大于 0: %v", target.Lever)
+	}
+	if target.MgnMode != "isolated" && target.MgnMode != "cross" {
+		return nil, fmt.Errorf("不支持的保证金模式: %s", target.MgnMode)
+	}
+	if maxLever > 0 && target.Lever > maxLever {
+		return nil, fmt.Errorf("%s 杠杆倍数 %v 超过最大杠杆 %v", target.InstId, target.Lever, maxLever)
+	}
+
+	current, err := get()
+	if err != nil {
+		return nil, err
+	}
+	result := &LeverageResult{
+		InstId:   target.InstId,
+		MgnMode:  target.MgnMode,
+		PosSide:  target.PosSide,
+		Previous: current,
+		Current:  current,
+		MaxLever: maxLever,
+	}
+	if math.Abs(current-target.Lever) < 1e-9 {
+		return result, nil
+	}
+
+	if err := set(); err != nil {
+		return result, err
+	}
+	result.Current = target.Lever
+	result.Changed = true
+	fmt.Printf("[Leverage] %s %s %s 杠杆 %v -> %v\n", target.InstId, target.MgnMode, target.PosSide, result.Previous, result.Current)
+	return result, nil
+}
+
+func formatLever(lever float64) string {
+	return strconv.FormatFloat(lever, 'f', -1, 64)
+}
@@ -0,0 +1,109 @@
+package galatvtr
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Candle K线数据，OKX 与 Gate 统一使用该结构
+type Candle struct {
+	Ts       int64   // 开始时间，Unix 毫秒时间戳
+	Open     float64 // 开盘价
+	High     float64 // 最高价
+	Low      float64 // 最低价
+	Close    float64 // 收盘价
+	Vol      float64 // 成交量，现货为交易货币数量，合约为张数
+	VolQuote float64 // 成交额，以计价货币为单位
+	Confirm  bool    // K线是否已完结
+}
+
+// OrderBookLevel 深度档位
+type OrderBookLevel struct {
+	Px float64 // 价格
+	Sz float64 // 数量，现货为交易货币数量，合约为张数
+}
+
+// OrderBook 深度数据，Asks 按价格升序，Bids 按价格降序
+type OrderBook struct {
+	InstId string           // 产品ID
+	Asks   []OrderBookLevel // 卖方深度
+	Bids   []OrderBookLevel // 买方深度
+	Ts     int64            // 数据产生时间，Unix 毫秒时间戳
+}
+
+// MarketTrade 公共成交数据
+type MarketTrade struct {
+	TradeId string  // 成交ID
+	InstId  string  // 产品ID
+	Side    string  // 吃单方向 buy/sell
+	Px      float64 // 成交价格
+	Sz      float64 // 成交数量，现货为交易货币数量，合约为张数
+	Ts      int64   // 成交时间，Unix 毫秒时间戳
+}
+
+// FundingRate 资金费率记录
+type FundingRate struct {
+	InstId string  // 产品ID
+	Rate   float64 // 资金费率
+	Ts     int64   // 结算时间，Unix 毫秒时间戳
+}
+
+// ParseOkxCandles 将 OkGetKlineFecher 返回的原始K线数据转换为 Candle，结果按时间升序
+func ParseOkxCandles(data [][]string) ([]Candle, error) {
+	candles := make([]Candle, 0, len(data))
+	for _, row := range data {
+		// [ts,o,h,l,c,vol,volCcy,volCcyQuote,confirm]
+		if len(row) < 9 {
+			return nil, fmt.Errorf("K线数据格式错误: %v", row)
+		}
+		values, err := parseFloats(row[1:8])
+		if err != nil {
+			return nil, err
+		}
+		ts, err := strconv.ParseInt(row[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("解析K线时间失败: %v", err)
+		}
+		candles = append(candles, Candle{
+			Ts:       ts,
+			Open:     values[0],
+			High:     values[1],
+			Low:      values[2],
+			Close:    values[3],
+			Vol:      values[4],
+			VolQuote: values[6],
+			Confirm:  row[8] == "1",
+		})
+	}
+	return sortCandles(candles), nil
+}
+
+// sortCandles 按时间升序排序并去除重复的K线
+func sortCandles(candles []Candle) []Candle {
+	sort.Slice(candles, func(i, j int) bool { return candles[i].Ts < candles[j].Ts })
+	result := candles[:0]
+	for _, candle := range candles {
+		if len(result) > 0 && result[len(result)-1].Ts == candle.Ts {
+			continue
+		}
+		result = append(result, candle)
+	}
+	return result
+}
+
+// parseFloats 批量将字符串转换为 float64，空字符串视为 0
+func parseFloats(values []string) ([]float64, error) {
+	result := make([]float64, len(values))
+	for i, value := range values {
+		if value == "" {
+			continue
+		}
+		valueFloat, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("解析数值失败: %v", err)
+		}
+		result[i] = valueFloat
+	}
+	return result, nil
+}
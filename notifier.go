@@ -0,0 +1,181 @@
+package galatvtr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// NotifyLevel 消息级别
+type NotifyLevel string
+
+const (
+	NotifyInfo    NotifyLevel = "info"
+	NotifySuccess NotifyLevel = "success"
+	NotifyWarning NotifyLevel = "warning"
+	NotifyError   NotifyLevel = "error"
+)
+
+// rank 返回级别的严重程度，未知级别按 info 处理
+func (l NotifyLevel) rank() int {
+	switch l {
+	case NotifySuccess:
+		return 1
+	case NotifyWarning:
+		return 2
+	case NotifyError:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Message 推送消息
+type Message struct {
+	Title string      `json:"title"`          // 标题
+	Body  string      `json:"body"`           // 正文
+	Level NotifyLevel `json:"level"`          // 级别，默认 info
+	Tags  []string    `json:"tags,omitempty"` // 标签，如产品ID、策略名，用于分组和检索
+}
+
+// Text 将消息格式化为纯文本，供不支持标题的渠道使用
+func (m Message) Text() string {
+	var b strings.Builder
+	if m.Level != "" && m.Level != NotifyInfo {
+		b.WriteString("[" + strings.ToUpper(string(m.Level)) + "] ")
+	}
+	b.WriteString(m.Title)
+	if m.Body != "" {
+		if m.Title != "" {
+			b.WriteString("\n")
+		}
+		b.WriteString(m.Body)
+	}
+	if len(m.Tags) > 0 {
+		b.WriteString("\n#" + strings.Join(m.Tags, " #"))
+	}
+	return b.String()
+}
+
+// Notifier 消息推送渠道
+type Notifier interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// 推送请求的默认超时时间
+const defaultNotifyTimeout = 10 * time.Second
+
+var defaultNotifyClient = &http.Client{Timeout: defaultNotifyTimeout}
+
+// NotifyConfig 推送配置，未配置的渠道为 nil，可以直接从 JSON 配置文件解析
+type NotifyConfig struct {
+	MinLevel NotifyLevel       `json:"minLevel,omitempty"` // 低于该级别的消息不推送
+	Bark     *BarkNotifier     `json:"bark,omitempty"`
+	DingTalk *DingTalkNotifier `json:"dingtalk,omitempty"`
+	WeCom    *WeComNotifier    `json:"wecom,omitempty"`
+	Feishu   *FeishuNotifier   `json:"feishu,omitempty"`
+	Telegram *TelegramNotifier `json:"telegram,omitempty"`
+	Slack    *SlackNotifier    `json:"slack,omitempty"`
+	Discord  *DiscordNotifier  `json:"discord,omitempty"`
+	Webhook  *WebhookNotifier  `json:"webhook,omitempty"`
+	Email    *EmailNotifier    `json:"email,omitempty"`
+}
+
+// NewNotifier 按配置创建推送到全部已配置渠道的 MultiNotifier
+func NewNotifier(config NotifyConfig) *MultiNotifier {
+	multi := &MultiNotifier{MinLevel: config.MinLevel}
+	if config.Bark != nil {
+		multi.Notifiers = append(multi.Notifiers, config.Bark)
+	}
+	if config.DingTalk != nil {
+		multi.Notifiers = append(multi.Notifiers, config.DingTalk)
+	}
+	if config.WeCom != nil {
+		multi.Notifiers = append(multi.Notifiers, config.WeCom)
+	}
+	if config.Feishu != nil {
+		multi.Notifiers = append(multi.Notifiers, config.Feishu)
+	}
+	if config.Telegram != nil {
+		multi.Notifiers = append(multi.Notifiers, config.Telegram)
+	}
+	if config.Slack != nil {
+		multi.Notifiers = append(multi.Notifiers, config.Slack)
+	}
+	if config.Discord != nil {
+		multi.Notifiers = append(multi.Notifiers, config.Discord)
+	}
+	if config.Webhook != nil {
+		multi.Notifiers = append(multi.Notifiers, config.Webhook)
+	}
+	if config.Email != nil {
+		multi.Notifiers = append(multi.Notifiers, config.Email)
+	}
+	return multi
+}
+
+// MultiNotifier 将消息并发推送到多个渠道，单个渠道失败不影响其它渠道
+type MultiNotifier struct {
+	Notifiers []Notifier
+	MinLevel  NotifyLevel // 低于该级别的消息不推送
+}
+
+// Send 推送到全部渠道，返回各渠道错误的合并
+func (m *MultiNotifier) Send(ctx context.Context, msg Message) error {
+	if msg.Level.rank() < m.MinLevel.rank() {
+		return nil
+	}
+	errs := make([]error, len(m.Notifiers))
+	var wg sync.WaitGroup
+	for i, n := range m.Notifiers {
+		wg.Add(1)
+		go func(i int, n Notifier) {
+			defer wg.Done()
+			if err := n.Send(ctx, msg); err != nil {
+				errs[i] = fmt.Errorf("%s: %w", strings.TrimPrefix(fmt.Sprintf("%T", n), "*galatvtr."), err)
+			}
+		}(i, n)
+	}
+	wg.Wait()
+	err := errors.Join(errs...)
+	if err != nil {
+		fmt.Printf("[Notify] 推送失败: %v\n", err)
+	}
+	return err
+}
+
+// postJSON 发送 JSON 请求，返回响应内容，HTTP 状态码不是 2xx 时返回错误
+func postJSON(ctx context.Context, client *http.Client, url string, payload interface{}, headers map[string]string) ([]byte, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if client == nil {
+		client = defaultNotifyClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return body, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
+	}
+	return body, nil
+}
@@ -0,0 +1,144 @@
+package galatvtr
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// AccountRisk 账户风险快照
+type AccountRisk struct {
+	Ts        time.Time      // 快照时间
+	TotalEq   float64        // 美金层面权益
+	AdjEq     float64        // 美金层面有效保证金
+	Imr       float64        // 美金层面占用保证金
+	Mmr       float64        // 美金层面维持保证金
+	MgnRatio  float64        // 美金层面保证金率，没有占用保证金时为 0
+	AtRisk    bool           // 组合保证金账户是否处于风险状态，其它账户模式始终为 false
+	Positions []PositionRisk // 各持仓的风险
+}
+
+// PositionRisk 单个持仓的风险和可加仓数量
+type PositionRisk struct {
+	InstId      string  // 产品ID
+	InstType    string  // 产品类型
+	MgnMode     string  // 保证金模式
+	PosSide     string  // 持仓方向
+	Pos         float64 // 持仓数量，单向持仓模式下负数表示空仓
+	Lever       float64 // 杠杆倍率
+	AvgPx       float64 // 开仓均价
+	MarkPx      float64 // 最新标记价格
+	LiqPx       float64 // 预估强平价，没有强平价时为 0
+	MgnRatio    float64 // 保证金率
+	LiqDistance float64 // 标记价格到强平价的距离占标记价格的比例，没有强平价时为 1
+	MaxBuy      float64 // 按当前杠杆最大可买数量
+	MaxSell     float64 // 按当前杠杆最大可卖数量
+	AvailBuy    float64 // 最大买入可用数量
+	AvailSell   float64 // 最大卖出可用数量
+	MaxLoan     float64 // 币币杠杆最大可借，其它产品类型为 0
+}
+
+// GetAccountRisk 获取账户风险快照，instType 为空时包含全部持仓。
+// 单个持仓的可下单数量查询失败时不返回错误，对应字段保持为 0
+func (c *OKXClient) GetAccountRisk(instType string) (*AccountRisk, error) {
+	balance, err := c.GetAccountBalance("")
+	if err != nil {
+		return nil, err
+	}
+	risk := &AccountRisk{Ts: time.Now()}
+	if len(balance.Data) > 0 {
+		data := balance.Data[0]
+		risk.TotalEq = parseRiskFloat(data.TotalEq)
+		risk.AdjEq = parseRiskFloat(data.AdjEq)
+		risk.Imr = parseRiskFloat(data.Imr)
+		risk.Mmr = parseRiskFloat(data.Mmr)
+		risk.MgnRatio = parseRiskFloat(data.MgnRatio)
+	}
+
+	// 风险状态只适用于组合保证金账户，其它账户模式会返回错误
+	if state, err := c.GetRiskState(); err == nil && len(state.Data) > 0 {
+		risk.AtRisk = state.Data[0].AtRisk
+	}
+
+	positions, err := c.GetPositions(instType, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, position := range positions.Data {
+		risk.Positions = append(risk.Positions, c.positionRisk(position))
+	}
+	return risk, nil
+}
+
+func (c *OKXClient) positionRisk(position PositionData) PositionRisk {
+	risk := PositionRisk{
+		InstId:   position.InstId,
+		InstType: position.InstType,
+		MgnMode:  position.MgnMode,
+		PosSide:  position.PosSide,
+		Pos:      parseRiskFloat(position.Pos),
+		Lever:    parseRiskFloat(position.Lever),
+		AvgPx:    parseRiskFloat(position.AvgPx),
+		MarkPx:   parseRiskFloat(position.MarkPx),
+		LiqPx:    parseRiskFloat(position.LiqPx),
+		MgnRatio: parseRiskFloat(position.MgnRatio),
+	}
+	risk.LiqDistance = liqDistance(risk.MarkPx, risk.LiqPx)
+
+	maxSize, err := c.GetMaxSize(position.InstId, position.MgnMode, "", "", "")
+	if err != nil {
+		fmt.Printf("[Risk] %s 获取最大可下单数量失败: %v\n", position.InstId, err)
+	} else if len(maxSize.Data) > 0 {
+		risk.MaxBuy = parseRiskFloat(maxSize.Data[0].MaxBuy)
+		risk.MaxSell = parseRiskFloat(maxSize.Data[0].MaxSell)
+	}
+
+	availSize, err := c.GetMaxAvailSize(position.InstId, position.MgnMode, "", false)
+	if err != nil {
+		fmt.Printf("[Risk] %s 获取最大可用数量失败: %v\n", position.InstId, err)
+	} else if len(availSize.Data) > 0 {
+		risk.AvailBuy = parseRiskFloat(availSize.Data[0].AvailBuy)
+		risk.AvailSell = parseRiskFloat(availSize.Data[0].AvailSell)
+	}
+
+	if position.InstType == "MARGIN" {
+		// 逐仓杠杆需指定保证金币种，这里按计价货币查询
+		mgnCcy := ""
+		if position.MgnMode == "isolated" {
+			mgnCcy = position.QuoteCcy
+		}
+		maxLoan, err := c.GetMaxLoan(position.InstId, position.MgnMode, mgnCcy)
+		if err != nil {
+			fmt.Printf("[Risk] %s 获取最大可借失败: %v\n", position.InstId, err)
+		} else if len(maxLoan.Data) > 0 {
+			risk.MaxLoan = parseRiskFloat(maxLoan.Data[0].MaxLoan)
+		}
+	}
+	return risk
+}
+
+// NearLiquidation 返回强平距离小于 threshold 的持仓，如 threshold 为 0.1 表示标记价格距离强平价不足 10%
+func (r *AccountRisk) NearLiquidation(threshold float64) []PositionRisk {
+	var result []PositionRisk
+	for _, position := range r.Positions {
+		if position.LiqDistance < threshold {
+			result = append(result, position)
+		}
+	}
+	return result
+}
+
+// liqDistance 计算标记价格到强平价的距离占标记价格的比例
+func liqDistance(markPx, liqPx float64) float64 {
+	if liqPx <= 0 || markPx <= 0 {
+		return 1
+	}
+	return math.Abs(markPx-liqPx) / markPx
+}
+
+// parseRiskFloat 解析 OKX 返回的数值，空字符串视为 0
+func parseRiskFloat(value string) float64 {
+	result, _ := strconv.ParseFloat(value, 64)
+	return result
+}
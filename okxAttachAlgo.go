@@ -0,0 +1,196 @@
+package galatvtr
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// TakeProfitLevel 单个止盈档位
+type TakeProfitLevel struct {
+	TriggerPx string // 止盈触发价
+	OrdPx     string // 止盈委托价，为空时按市价（-1）
+	Sz        string // 该档位的数量，分批止盈时必填，各档位数量之和须等于订单数量
+}
+
+// AttachTpSlOptions 下单附带止盈止损配置
+type AttachTpSlOptions struct {
+	TakeProfits   []TakeProfitLevel // 止盈档位，多个档位时为分批止盈
+	SlTriggerPx   string            // 止损触发价，为空时不设止损
+	SlOrdPx       string            // 止损委托价，为空时按市价（-1）
+	TriggerPxType string            // 触发价类型 last：最新价格 index：指数价格 mark：标记价格，默认 last
+	ClOrdIdPrefix string            // 客户自定义策略订单ID前缀，设置后各档位依次为 前缀+序号
+	BreakEvenSl   bool              // 分批止盈时，第一档止盈触发后将止损移动到开仓均价
+}
+
+// BuildAttachAlgoOrds 根据配置生成 AttachAlgoOrds，分批止盈时每个档位都带上相同的止损，
+// 使每一批仓位都同时受止盈和止损保护
+func BuildAttachAlgoOrds(opts AttachTpSlOptions) []AttachAlgoOrd {
+	newOrd := func(i int) AttachAlgoOrd {
+		ord := AttachAlgoOrd{}
+		if opts.ClOrdIdPrefix != "" {
+			ord.AttachAlgoClOrdId = opts.ClOrdIdPrefix + strconv.Itoa(i)
+		}
+		if opts.SlTriggerPx != "" {
+			ord.SlTriggerPx = opts.SlTriggerPx
+			ord.SlOrdPx = marketOrdPx(opts.SlOrdPx)
+			ord.SlTriggerPxType = opts.TriggerPxType
+		}
+		return ord
+	}
+
+	if len(opts.TakeProfits) == 0 {
+		if opts.SlTriggerPx == "" {
+			return nil
+		}
+		return []AttachAlgoOrd{newOrd(0)}
+	}
+
+	split := len(opts.TakeProfits) > 1
+	ords := make([]AttachAlgoOrd, 0, len(opts.TakeProfits))
+	for i, level := range opts.TakeProfits {
+		ord := newOrd(i)
+		ord.TpTriggerPx = level.TriggerPx
+		ord.TpOrdPx = marketOrdPx(level.OrdPx)
+		ord.TpTriggerPxType = opts.TriggerPxType
+		if split {
+			ord.Sz = level.Sz
+			if opts.BreakEvenSl && ord.SlTriggerPx != "" {
+				ord.AmendPxOnTriggerType = "1"
+			}
+		}
+		ords = append(ords, ord)
+	}
+	return ords
+}
+
+func marketOrdPx(px string) string {
+	if px == "" {
+		return "-1"
+	}
+	return px
+}
+
+// ValidateAttachAlgoOrds 校验附带止盈止损：买入时止盈触发价须高于 entryPx、止损触发价须低于 entryPx，卖出时相反；
+// 分批止盈时各档位须指定数量，且数量之和等于订单数量 sz
+func ValidateAttachAlgoOrds(side string, entryPx float64, sz string, ords []AttachAlgoOrd) error {
+	if side != "buy" && side != "sell" {
+		return fmt.Errorf("不支持的订单方向: %s", side)
+	}
+	if entryPx <= 0 {
+		return fmt.Errorf("开仓价格必须大于 0")
+	}
+
+	var totalSz float64
+	for i, ord := range ords {
+		for _, pxType := range []string{ord.TpTriggerPxType, ord.SlTriggerPxType} {
+			if pxType != "" && pxType != "last" && pxType != "index" && pxType != "mark" {
+				return fmt.Errorf("第%d个止盈止损的触发价类型无效: %s", i+1, pxType)
+			}
+		}
+		if ord.TpTriggerPx == "" && ord.SlTriggerPx == "" {
+			return fmt.Errorf("第%d个止盈止损未设置触发价", i+1)
+		}
+		if ord.TpTriggerPx != "" {
+			tp, err := strconv.ParseFloat(ord.TpTriggerPx, 64)
+			if err != nil {
+				return fmt.Errorf("第%d个止盈触发价无效: %s", i+1, ord.TpTriggerPx)
+			}
+			if side == "buy" && tp <= entryPx || side == "sell" && tp >= entryPx {
+				return fmt.Errorf("第%d个止盈触发价 %s 与开仓价格 %v 方向不符（%s）", i+1, ord.TpTriggerPx, entryPx, side)
+			}
+		}
+		if ord.SlTriggerPx != "" {
+			sl, err := strconv.ParseFloat(ord.SlTriggerPx, 64)
+			if err != nil {
+				return fmt.Errorf("第%d个止损触发价无效: %s", i+1, ord.SlTriggerPx)
+			}
+			if side == "buy" && sl >= entryPx || side == "sell" && sl <= entryPx {
+				return fmt.Errorf("第%d个止损触发价 %s 与开仓价格 %v 方向不符（%s）", i+1, ord.SlTriggerPx, entryPx, side)
+			}
+		}
+		if len(ords) > 1 {
+			levelSz, err := strconv.ParseFloat(ord.Sz, 64)
+			if err != nil || levelSz <= 0 {
+				return fmt.Errorf("分批止盈第%d档的数量无效: %q", i+1, ord.Sz)
+			}
+			totalSz += levelSz
+		}
+	}
+
+	if len(ords) > 1 {
+		orderSz, err := strconv.ParseFloat(sz, 64)
+		if err != nil {
+			return fmt.Errorf("订单数量无效: %s", sz)
+		}
+		if math.Abs(totalSz-orderSz) > 1e-9 {
+			return fmt.Errorf("分批止盈数量之和 %v 不等于订单数量 %v", totalSz, orderSz)
+		}
+	}
+	return nil
+}
+
+// validateOrderAttachAlgoOrds 限价单以委托价格作为开仓价格校验附带止盈止损，市价单需调用方用参考价格自行校验
+func validateOrderAttachAlgoOrds(order OrderRequestOkx) error {
+	if len(order.AttachAlgoOrds) == 0 || order.Px == "" {
+		return nil
+	}
+	px, err := strconv.ParseFloat(order.Px, 64)
+	if err != nil {
+		return fmt.Errorf("委托价格无效: %s", order.Px)
+	}
+	return ValidateAttachAlgoOrds(order.Side, px, order.Sz, order.AttachAlgoOrds)
+}
+
+// AmendAttachedTpSl 修改订单附带的止盈止损。amend 未指定 AttachAlgoId 和 AttachAlgoClOrdId 时修改第一个附带止盈止损。
+// 订单完全成交后，附带的止盈止损会转为以 attachAlgoClOrdId 为 algoClOrdId 的策略委托，此时改为修改该策略委托，
+// 因此需要在下单时设置 AttachAlgoClOrdId（如 AttachTpSlOptions.ClOrdIdPrefix）
+func (c *OKXClient) AmendAttachedTpSl(instId, ordId string, amend AmendAttachAlgoOrd) error {
+	info, err := c.GetOrderInfo(instId, ordId, "")
+	if err != nil {
+		return err
+	}
+	if len(info.Data) == 0 {
+		return fmt.Errorf("未查询到订单: %s", ordId)
+	}
+	order := info.Data[0]
+
+	if amend.AttachAlgoId == "" && amend.AttachAlgoClOrdId == "" {
+		if len(order.AttachAlgoOrds) == 0 {
+			return fmt.Errorf("订单 %s 没有附带止盈止损", ordId)
+		}
+		amend.AttachAlgoId = order.AttachAlgoOrds[0].AttachAlgoId
+		amend.AttachAlgoClOrdId = order.AttachAlgoOrds[0].AttachAlgoClOrdId
+	} else if amend.AttachAlgoClOrdId == "" {
+		for _, attached := range order.AttachAlgoOrds {
+			if attached.AttachAlgoId == amend.AttachAlgoId {
+				amend.AttachAlgoClOrdId = attached.AttachAlgoClOrdId
+			}
+		}
+	}
+
+	if order.State != "filled" {
+		_, err = c.AmendOrder(AmendOrderRequest{
+			InstId:         instId,
+			OrdId:          ordId,
+			AttachAlgoOrds: []AmendAttachAlgoOrd{amend},
+		})
+		return err
+	}
+
+	if amend.AttachAlgoClOrdId == "" {
+		return fmt.Errorf("订单 %s 已成交，附带止盈止损未设置 attachAlgoClOrdId，无法定位对应的策略委托", ordId)
+	}
+	_, err = c.AmendAlgoOrder(AmendAlgoOrderRequest{
+		InstId:             instId,
+		AlgoClOrdId:        amend.AttachAlgoClOrdId,
+		NewSz:              amend.Sz,
+		NewTpTriggerPx:     amend.NewTpTriggerPx,
+		NewTpOrdPx:         amend.NewTpOrdPx,
+		NewSlTriggerPx:     amend.NewSlTriggerPx,
+		NewSlOrdPx:         amend.NewSlOrdPx,
+		NewTpTriggerPxType: amend.NewTpTriggerPxType,
+		NewSlTriggerPxType: amend.NewSlTriggerPxType,
+	})
+	return err
+}
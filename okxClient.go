@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -25,6 +26,30 @@ type OKXClient struct {
 	apiSecret  string
 	passphrase string
 	isTestnet  int
+
+	withdrawEnabled   bool                // 是否允许提币
+	withdrawWhitelist map[string]struct{} // 允许提币的地址，key 为 币种:链:地址
+}
+
+// OKXClientConfig OKX 客户端配置
+type OKXClientConfig struct {
+	BaseUrl    string        // API 基础 URL，为空时使用 https://www.okx.com
+	ApiKey     string        // API Key
+	ApiSecret  string        // API Secret
+	Passphrase string        // API 密码
+	IsTestnet  int           // 1 为模拟盘
+	Timeout    time.Duration // 请求超时时间，默认 10 秒
+
+	// 提币默认关闭，需显式开启，且只能提到白名单中的地址
+	WithdrawEnabled   bool
+	WithdrawWhitelist []WithdrawAddress
+}
+
+// WithdrawAddress 提币白名单地址
+type WithdrawAddress struct {
+	Ccy   string // 币种，如 USDT
+	Chain string // 链名称，如 USDT-TRC20
+	Addr  string // 提币地址，内部转账时为手机号、邮箱或账户名
 }
 
 func NewOkClientWithoutKey(baseUrl string) *OKXClient {
@@ -56,6 +81,25 @@ func NewOKXClient(baseUrl, apiKey, apiSecret, passphrase string, isTestnet int)
 	}
 }
 
+// NewOKXClientWithConfig 按配置创建 OKX API 客户端
+func NewOKXClientWithConfig(config OKXClientConfig) *OKXClient {
+	c := NewOKXClient(config.BaseUrl, config.ApiKey, config.ApiSecret, config.Passphrase, config.IsTestnet)
+	if config.Timeout > 0 {
+		c.Client.Timeout = config.Timeout
+	}
+	c.withdrawEnabled = config.WithdrawEnabled
+	c.withdrawWhitelist = make(map[string]struct{}, len(config.WithdrawWhitelist))
+	for _, address := range config.WithdrawWhitelist {
+		address.Ccy = strings.ToUpper(address.Ccy)
+		c.withdrawWhitelist[address.key()] = struct{}{}
+	}
+	return c
+}
+
+func (a WithdrawAddress) key() string {
+	return a.Ccy + ":" + a.Chain + ":" + a.Addr
+}
+
 // 发送请求到 OKX API
 func (c *OKXClient) SendRequest(method, endpoint string, params interface{}) ([]byte, error) {
 	var reqBody []byte
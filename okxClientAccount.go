@@ -3,6 +3,7 @@ package galatvtr
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 )
 
 // 查询指定币种余额
@@ -82,7 +83,7 @@ func (c *OKXClient) GetLeverageInfo(instId, mgnMode string) (*LeverageInfoRespon
 }
 
 // SetLeverage 设置杠杆倍率
-func (c *OKXClient) SetLeverage(apiKey, secretKey, passphrase string, isTestnet int, request SetLeverageRequest) (*SetLeverageResponse, error) {
+func (c *OKXClient) SetLeverage(request SetLeverageRequest) (*SetLeverageResponse, error) {
 	endpoint := "/api/v5/account/set-leverage"
 
 	resp, err := c.SendRequest("POST", endpoint, request)
@@ -101,3 +102,124 @@ func (c *OKXClient) SetLeverage(apiKey, secretKey, passphrase string, isTestnet
 
 	return &result, nil
 }
+
+// GetLeverage 查询当前杠杆倍数，开平仓模式下的逐仓按 PosSide 匹配
+func (c *OKXClient) GetLeverage(target LeverageTarget) (float64, error) {
+	result, err := c.GetLeverageInfo(target.InstId, target.MgnMode)
+	if err != nil {
+		return 0, err
+	}
+	for _, data := range result.Data {
+		if target.PosSide == "" || data.PosSide == target.PosSide || data.PosSide == "net" {
+			return strconv.ParseFloat(data.Lever, 64)
+		}
+	}
+	return 0, fmt.Errorf("未查询到 %s %s %s 的杠杆倍率", target.InstId, target.MgnMode, target.PosSide)
+}
+
+// EnsureLeverage 校验目标杠杆不超过产品的最大杠杆，与当前杠杆不同时才设置
+func (c *OKXClient) EnsureLeverage(target LeverageTarget) (*LeverageResult, error) {
+	instrument, err := c.GetInstrument(okxInstType(target.InstId), target.InstId)
+	if err != nil {
+		return nil, err
+	}
+	maxLever, _ := strconv.ParseFloat(instrument.Lever, 64)
+
+	return ensureLeverage(target, maxLever, func() (float64, error) {
+		return c.GetLeverage(target)
+	}, func() error {
+		_, err := c.SetLeverage(SetLeverageRequest{
+			InstId:  target.InstId,
+			Lever:   formatLever(target.Lever),
+			MgnMode: target.MgnMode,
+			PosSide: target.PosSide,
+		})
+		return err
+	})
+}
+
+// GetMaxSize 获取最大可下单数量，instId 可传多个，用逗号分隔，px 和 leverage 为空时按当前价格和杠杆计算
+func (c *OKXClient) GetMaxSize(instId, tdMode, ccy, px, leverage string) (*MaxSizeResponse, error) {
+	endpoint := okxQuery("/api/v5/account/max-size",
+		"instId", instId, "tdMode", tdMode, "ccy", ccy, "px", px, "leverage", leverage)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result MaxSizeResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取最大可下单数量失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetMaxAvailSize 获取最大可用数量，instId 可传多个，用逗号分隔
+func (c *OKXClient) GetMaxAvailSize(instId, tdMode, ccy string, reduceOnly bool) (*MaxAvailSizeResponse, error) {
+	endpoint := okxQuery("/api/v5/account/max-avail-size",
+		"instId", instId, "tdMode", tdMode, "ccy", ccy, "reduceOnly", strconv.FormatBool(reduceOnly))
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result MaxAvailSizeResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取最大可用数量失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetMaxLoan 获取交易产品最大可借，mgnMode 为 isolated 或 cross
+func (c *OKXClient) GetMaxLoan(instId, mgnMode, mgnCcy string) (*MaxLoanResponse, error) {
+	endpoint := okxQuery("/api/v5/account/max-loan", "instId", instId, "mgnMode", mgnMode, "mgnCcy", mgnCcy)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result MaxLoanResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取最大可借失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetRiskState 查看账户特定风险状态，仅适用于组合保证金账户
+func (c *OKXClient) GetRiskState() (*RiskStateResponse, error) {
+	endpoint := "/api/v5/account/risk-state"
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result RiskStateResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("查看账户风险状态失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
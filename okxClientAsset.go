@@ -3,6 +3,7 @@ package galatvtr
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // GetAssetBalance 获取资金账户余额
@@ -54,3 +55,159 @@ func (c *OKXClient) AssetTransfer(request AssetTransferRequest) (*AssetTransferR
 
 	return &result, nil
 }
+
+// GetAssetCurrencies 获取币种列表，包含各链的充提状态、最小提币数量和手续费，ccy 为空时返回全部，多个币种用逗号分隔
+func (c *OKXClient) GetAssetCurrencies(ccy string) (*AssetCurrenciesResponse, error) {
+	endpoint := okxQuery("/api/v5/asset/currencies", "ccy", ccy)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AssetCurrenciesResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取币种列表失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetDepositAddress 获取充值地址
+func (c *OKXClient) GetDepositAddress(ccy string) (*DepositAddressResponse, error) {
+	if ccy == "" {
+		return nil, fmt.Errorf("ccy 参数不能为空")
+	}
+	endpoint := okxQuery("/api/v5/asset/deposit-address", "ccy", ccy)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result DepositAddressResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取充值地址失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetDepositHistory 获取充值记录，after/before 为毫秒时间戳，参数为空时不作筛选
+func (c *OKXClient) GetDepositHistory(ccy, state, after, before, limit string) (*DepositHistoryResponse, error) {
+	endpoint := okxQuery("/api/v5/asset/deposit-history",
+		"ccy", ccy, "state", state, "after", after, "before", before, "limit", limit)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result DepositHistoryResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取充值记录失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// Withdrawal 提币，客户端须通过 OKXClientConfig 显式开启提币，且收币地址必须在白名单中
+func (c *OKXClient) Withdrawal(request WithdrawalRequest) (*WithdrawalResponse, error) {
+	endpoint := "/api/v5/asset/withdrawal"
+
+	if err := c.checkWithdrawal(request); err != nil {
+		return nil, err
+	}
+
+	// 打印请求参数
+	fmt.Printf("提币参数: %+v\n", request)
+
+	resp, err := c.SendRequest("POST", endpoint, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var result WithdrawalResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("提币失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// checkWithdrawal 检查提币是否已开启，以及收币地址是否在白名单中
+func (c *OKXClient) checkWithdrawal(request WithdrawalRequest) error {
+	if !c.withdrawEnabled {
+		return fmt.Errorf("提币未开启，请在 OKXClientConfig 中设置 WithdrawEnabled")
+	}
+	if request.Dest != "3" && request.Dest != "4" {
+		return fmt.Errorf("不支持的提币方式: %s", request.Dest)
+	}
+	if request.Dest == "4" && request.Chain == "" {
+		return fmt.Errorf("链上提币必须指定 chain")
+	}
+	address := WithdrawAddress{Ccy: strings.ToUpper(request.Ccy), Chain: request.Chain, Addr: request.ToAddr}
+	if _, ok := c.withdrawWhitelist[address.key()]; !ok {
+		return fmt.Errorf("收币地址不在白名单中: %s %s %s", address.Ccy, address.Chain, address.Addr)
+	}
+	return nil
+}
+
+// GetWithdrawalHistory 获取提币记录，after/before 为毫秒时间戳，参数为空时不作筛选
+func (c *OKXClient) GetWithdrawalHistory(ccy, wdId, state, after, before, limit string) (*WithdrawalHistoryResponse, error) {
+	endpoint := okxQuery("/api/v5/asset/withdrawal-history",
+		"ccy", ccy, "wdId", wdId, "state", state, "after", after, "before", before, "limit", limit)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result WithdrawalHistoryResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取提币记录失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetAssetValuation 获取账户资产估值，ccy 为估值单位，如 USDT、BTC，为空时默认 BTC
+func (c *OKXClient) GetAssetValuation(ccy string) (*AssetValuationResponse, error) {
+	endpoint := okxQuery("/api/v5/asset/asset-valuation", "ccy", ccy)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AssetValuationResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取账户资产估值失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
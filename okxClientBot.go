@@ -0,0 +1,339 @@
+package galatvtr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// PlaceGridOrder 网格策略委托下单，支持现货网格和合约网格
+func (c *OKXClient) PlaceGridOrder(request GridOrderRequest) (*TradingBotOrderResponse, error) {
+	if request.AlgoOrdType != "grid" && request.AlgoOrdType != "contract_grid" {
+		return nil, fmt.Errorf("不支持的网格策略类型: %s", request.AlgoOrdType)
+	}
+	if request.AlgoOrdType == "grid" && request.QuoteSz == "" && request.BaseSz == "" {
+		return nil, fmt.Errorf("现货网格 quoteSz 和 baseSz 至少需要提供一个")
+	}
+	if request.AlgoOrdType == "contract_grid" && (request.Sz == "" || request.Direction == "" || request.Lever == "") {
+		return nil, fmt.Errorf("合约网格 sz、direction 和 lever 参数不能为空")
+	}
+
+	// 打印网格下单参数
+	fmt.Printf("网格下单参数: %+v\n", request)
+
+	return c.tradingBotPost("/api/v5/tradingBot/grid/order-algo", request, "网格策略下单")
+}
+
+// AmendGridOrder 修改网格策略订单的止盈止损
+func (c *OKXClient) AmendGridOrder(request GridAmendRequest) (*TradingBotOrderResponse, error) {
+	if request.AlgoId == "" || request.InstId == "" {
+		return nil, fmt.Errorf("algoId 和 instId 参数不能为空")
+	}
+	return c.tradingBotPost("/api/v5/tradingBot/grid/amend-order-algo", request, "修改网格策略")
+}
+
+// StopGridOrders 停止网格策略订单，单次最多 10 个
+func (c *OKXClient) StopGridOrders(requests []GridStopRequest) (*TradingBotOrderResponse, error) {
+	if len(requests) == 0 {
+		return nil, fmt.Errorf("停止的网格策略不能为空")
+	}
+	return c.tradingBotPost("/api/v5/tradingBot/grid/stop-order-algo", requests, "停止网格策略")
+}
+
+// StopGridOrdersByInstId 停止指定产品上所有运行中的网格策略，返回已停止的策略订单ID，
+// 便于信号只携带产品ID时关闭交易所端的网格
+func (c *OKXClient) StopGridOrdersByInstId(algoOrdType, instId, stopType string) ([]string, error) {
+	pending, err := c.GetGridOrdersPending(algoOrdType, "", instId, "", "", "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	var stopped []string
+	var errs []error
+	// 停止接口单次最多 10 个
+	for start := 0; start < len(pending.Data); start += 10 {
+		end := start + 10
+		if end > len(pending.Data) {
+			end = len(pending.Data)
+		}
+		var requests []GridStopRequest
+		for _, order := range pending.Data[start:end] {
+			requests = append(requests, GridStopRequest{
+				AlgoId:      order.AlgoId,
+				InstId:      order.InstId,
+				AlgoOrdType: order.AlgoOrdType,
+				StopType:    stopType,
+			})
+		}
+		result, err := c.StopGridOrders(requests)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if result == nil {
+			continue
+		}
+		for _, data := range result.Data {
+			if data.SCode == "0" {
+				stopped = append(stopped, data.AlgoId)
+			}
+		}
+	}
+	return stopped, errors.Join(errs...)
+}
+
+// GetGridOrdersPending 获取未完成的网格策略订单列表
+func (c *OKXClient) GetGridOrdersPending(algoOrdType, algoId, instId, instType, after, before, limit string) (*GridAlgoOrdersResponse, error) {
+	return c.getGridOrders("/api/v5/tradingBot/grid/orders-algo-pending", "获取未完成网格策略列表失败",
+		algoOrdType, algoId, instId, instType, after, before, limit)
+}
+
+// GetGridOrdersHistory 获取历史网格策略订单列表
+func (c *OKXClient) GetGridOrdersHistory(algoOrdType, algoId, instId, instType, after, before, limit string) (*GridAlgoOrdersResponse, error) {
+	return c.getGridOrders("/api/v5/tradingBot/grid/orders-algo-history", "获取历史网格策略列表失败",
+		algoOrdType, algoId, instId, instType, after, before, limit)
+}
+
+func (c *OKXClient) getGridOrders(endpoint, action, algoOrdType, algoId, instId, instType, after, before, limit string) (*GridAlgoOrdersResponse, error) {
+	if algoOrdType == "" {
+		return nil, fmt.Errorf("algoOrdType 参数不能为空")
+	}
+	endpoint = okxQuery(endpoint,
+		"algoOrdType", algoOrdType, "algoId", algoId, "instId", instId, "instType", instType,
+		"after", after, "before", before, "limit", limit)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result GridAlgoOrdersResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("%s: %s", action, result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetGridOrderDetails 获取网格策略订单详情
+func (c *OKXClient) GetGridOrderDetails(algoOrdType, algoId string) (*GridAlgoOrderData, error) {
+	if algoOrdType == "" || algoId == "" {
+		return nil, fmt.Errorf("algoOrdType 和 algoId 参数不能为空")
+	}
+	endpoint := okxQuery("/api/v5/tradingBot/grid/orders-algo-details", "algoOrdType", algoOrdType, "algoId", algoId)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result GridAlgoOrdersResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return nil, fmt.Errorf("获取网格策略详情失败: %s", result.Msg)
+	}
+	if len(result.Data) == 0 {
+		return nil, fmt.Errorf("未找到网格策略: %s", algoId)
+	}
+
+	return &result.Data[0], nil
+}
+
+// GetGridSubOrders 获取网格策略子订单，subType 为 live：未成交 filled：已成交
+func (c *OKXClient) GetGridSubOrders(algoOrdType, algoId, subType, groupId, after, before, limit string) (*GridSubOrdersResponse, error) {
+	if algoOrdType == "" || algoId == "" || subType == "" {
+		return nil, fmt.Errorf("algoOrdType、algoId 和 type 参数不能为空")
+	}
+	endpoint := okxQuery("/api/v5/tradingBot/grid/sub-orders",
+		"algoOrdType", algoOrdType, "algoId", algoId, "type", subType, "groupId", groupId,
+		"after", after, "before", before, "limit", limit)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result GridSubOrdersResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取网格策略子订单失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetGridPositions 获取合约网格持仓
+func (c *OKXClient) GetGridPositions(algoId string) (*GridPositionsResponse, error) {
+	if algoId == "" {
+		return nil, fmt.Errorf("algoId 参数不能为空")
+	}
+	endpoint := okxQuery("/api/v5/tradingBot/grid/positions", "algoOrdType", "contract_grid", "algoId", algoId)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result GridPositionsResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取合约网格持仓失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// PlaceRecurringBuy 定投策略委托下单
+func (c *OKXClient) PlaceRecurringBuy(request RecurringOrderRequest) (*TradingBotOrderResponse, error) {
+	if request.StgyName == "" || len(request.RecurringList) == 0 {
+		return nil, fmt.Errorf("stgyName 和 recurringList 参数不能为空")
+	}
+	if request.Period == "" || request.Amt == "" || request.InvestmentCcy == "" || request.TdMode == "" {
+		return nil, fmt.Errorf("period、amt、investmentCcy 和 tdMode 参数不能为空")
+	}
+
+	// 打印定投下单参数
+	fmt.Printf("定投下单参数: %+v\n", request)
+
+	return c.tradingBotPost("/api/v5/tradingBot/recurring/order-algo", request, "定投策略下单")
+}
+
+// AmendRecurringBuy 修改定投策略订单名称
+func (c *OKXClient) AmendRecurringBuy(request RecurringAmendRequest) (*TradingBotOrderResponse, error) {
+	if request.AlgoId == "" || request.StgyName == "" {
+		return nil, fmt.Errorf("algoId 和 stgyName 参数不能为空")
+	}
+	return c.tradingBotPost("/api/v5/tradingBot/recurring/amend-order-algo", request, "修改定投策略")
+}
+
+// StopRecurringBuys 停止定投策略订单，单次最多 10 个
+func (c *OKXClient) StopRecurringBuys(algoIds ...string) (*TradingBotOrderResponse, error) {
+	if len(algoIds) == 0 {
+		return nil, fmt.Errorf("停止的定投策略不能为空")
+	}
+	requests := make([]RecurringStopRequest, 0, len(algoIds))
+	for _, algoId := range algoIds {
+		requests = append(requests, RecurringStopRequest{AlgoId: algoId})
+	}
+	return c.tradingBotPost("/api/v5/tradingBot/recurring/stop-order-algo", requests, "停止定投策略")
+}
+
+// GetRecurringBuysPending 获取未完成的定投策略订单列表
+func (c *OKXClient) GetRecurringBuysPending(algoId, after, before, limit string) (*RecurringOrdersResponse, error) {
+	return c.getRecurringBuys("/api/v5/tradingBot/recurring/orders-algo-pending", "获取未完成定投策略列表失败",
+		algoId, after, before, limit)
+}
+
+// GetRecurringBuysHistory 获取历史定投策略订单列表
+func (c *OKXClient) GetRecurringBuysHistory(algoId, after, before, limit string) (*RecurringOrdersResponse, error) {
+	return c.getRecurringBuys("/api/v5/tradingBot/recurring/orders-algo-history", "获取历史定投策略列表失败",
+		algoId, after, before, limit)
+}
+
+func (c *OKXClient) getRecurringBuys(endpoint, action, algoId, after, before, limit string) (*RecurringOrdersResponse, error) {
+	endpoint = okxQuery(endpoint, "algoId", algoId, "after", after, "before", before, "limit", limit)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result RecurringOrdersResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("%s: %s", action, result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetRecurringBuyDetails 获取定投策略订单详情
+func (c *OKXClient) GetRecurringBuyDetails(algoId string) (*RecurringOrderData, error) {
+	if algoId == "" {
+		return nil, fmt.Errorf("algoId 参数不能为空")
+	}
+	endpoint := okxQuery("/api/v5/tradingBot/recurring/orders-algo-details", "algoId", algoId)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result RecurringOrdersResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return nil, fmt.Errorf("获取定投策略详情失败: %s", result.Msg)
+	}
+	if len(result.Data) == 0 {
+		return nil, fmt.Errorf("未找到定投策略: %s", algoId)
+	}
+
+	return &result.Data[0], nil
+}
+
+// GetRecurringBuySubOrders 获取定投策略子订单
+func (c *OKXClient) GetRecurringBuySubOrders(algoId, ordId, after, before, limit string) (*RecurringSubOrdersResponse, error) {
+	if algoId == "" {
+		return nil, fmt.Errorf("algoId 参数不能为空")
+	}
+	endpoint := okxQuery("/api/v5/tradingBot/recurring/sub-orders",
+		"algoId", algoId, "ordId", ordId, "after", after, "before", before, "limit", limit)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result RecurringSubOrdersResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取定投策略子订单失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// tradingBotPost 发送策略交易的写请求，失败时优先返回单个策略的错误信息
+func (c *OKXClient) tradingBotPost(endpoint string, body interface{}, action string) (*TradingBotOrderResponse, error) {
+	resp, err := c.SendRequest("POST", endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TradingBotOrderResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		for _, data := range result.Data {
+			if data.SCode != "0" && data.SMsg != "" {
+				return &result, fmt.Errorf("%s失败: %s", action, data.SMsg)
+			}
+		}
+		return &result, fmt.Errorf("%s失败: %s", action, result.Msg)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,313 @@
+package galatvtr
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GetConvertCurrencies 获取闪兑支持的币种列表
+func (c *OKXClient) GetConvertCurrencies() (*ConvertCurrenciesResponse, error) {
+	endpoint := "/api/v5/asset/convert/currencies"
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ConvertCurrenciesResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取闪兑币种列表失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetConvertCurrencyPair 获取闪兑币对信息，包含闪兑的最小和最大数量
+func (c *OKXClient) GetConvertCurrencyPair(fromCcy, toCcy string) (*ConvertCurrencyPairResponse, error) {
+	if fromCcy == "" || toCcy == "" {
+		return nil, fmt.Errorf("fromCcy 和 toCcy 参数不能为空")
+	}
+	endpoint := okxQuery("/api/v5/asset/convert/currency-pair", "fromCcy", fromCcy, "toCcy", toCcy)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ConvertCurrencyPairResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取闪兑币对信息失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// ConvertEstimateQuote 闪兑预估询价，返回的 quoteId 在 ttlMs 内可用于 ConvertTrade
+func (c *OKXClient) ConvertEstimateQuote(request ConvertEstimateQuoteRequest) (*ConvertEstimateQuoteResponse, error) {
+	endpoint := "/api/v5/asset/convert/estimate-quote"
+
+	resp, err := c.SendRequest("POST", endpoint, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ConvertEstimateQuoteResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("闪兑预估询价失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// ConvertTrade 闪兑交易，使用资金账户余额
+func (c *OKXClient) ConvertTrade(request ConvertTradeRequest) (*ConvertTradeResponse, error) {
+	endpoint := "/api/v5/asset/convert/trade"
+
+	// 打印请求参数
+	fmt.Printf("闪兑交易参数: %+v\n", request)
+
+	resp, err := c.SendRequest("POST", endpoint, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ConvertTradeResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("闪兑交易失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetConvertHistory 获取闪兑交易历史，after/before 为毫秒时间戳，参数为空时不作筛选
+func (c *OKXClient) GetConvertHistory(clTReqId, after, before, limit string) (*ConvertTradeResponse, error) {
+	endpoint := okxQuery("/api/v5/asset/convert/history",
+		"clTReqId", clTReqId, "after", after, "before", before, "limit", limit)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ConvertTradeResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取闪兑交易历史失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// DustSweepOptions 小额资产清理配置
+type DustSweepOptions struct {
+	Threshold float64  // 闪兑估值（以 ToCcy 计）低于该值的余额视为小额资产
+	ToCcy     string   // 兑换成的币种，默认 USDT
+	Exclude   []string // 不清理的币种
+	DryRun    bool     // 只询价，不划转和兑换
+}
+
+// DustSweepItem 单个币种的清理结果
+type DustSweepItem struct {
+	Ccy      string  // 币种
+	Trading  float64 // 交易账户可用余额
+	Funding  float64 // 资金账户可用余额
+	Value    float64 // 闪兑估值，以 ToCcy 计
+	Received float64 // 实际兑换得到的 ToCcy 数量
+	TradeId  string  // 闪兑成交ID
+	Skipped  string  // 未清理的原因，为空表示已清理（试运行时表示可清理）
+	Err      error   // 清理失败的原因
+}
+
+// SweepDustToUsdt 将交易账户和资金账户中估值低于 Threshold 的小额资产通过闪兑换成 ToCcy（默认 USDT）。
+// 闪兑使用资金账户余额，交易账户中的余额会先划转到资金账户。单个币种失败不影响其它币种，失败原因记录在 Err 中
+func (c *OKXClient) SweepDustToUsdt(opts DustSweepOptions) ([]DustSweepItem, error) {
+	toCcy := strings.ToUpper(opts.ToCcy)
+	if toCcy == "" {
+		toCcy = "USDT"
+	}
+	if opts.Threshold <= 0 {
+		return nil, fmt.Errorf("Threshold 必须大于 0")
+	}
+	exclude := map[string]bool{toCcy: true}
+	for _, ccy := range opts.Exclude {
+		exclude[strings.ToUpper(ccy)] = true
+	}
+
+	items := map[string]*DustSweepItem{}
+	item := func(ccy string) *DustSweepItem {
+		if items[ccy] == nil {
+			items[ccy] = &DustSweepItem{Ccy: ccy}
+		}
+		return items[ccy]
+	}
+
+	trading, err := c.GetAccountBalance("")
+	if err != nil {
+		return nil, err
+	}
+	for _, data := range trading.Data {
+		for _, detail := range data.Details {
+			availBal, _ := strconv.ParseFloat(detail.AvailBal, 64)
+			if availBal > 0 && !exclude[detail.Ccy] {
+				item(detail.Ccy).Trading = availBal
+			}
+		}
+	}
+
+	funding, err := c.GetAssetBalance("")
+	if err != nil {
+		return nil, err
+	}
+	for _, data := range funding.Data {
+		availBal, _ := strconv.ParseFloat(data.AvailBal, 64)
+		if availBal > 0 && !exclude[data.Ccy] {
+			item(data.Ccy).Funding = availBal
+		}
+	}
+
+	ccys := make([]string, 0, len(items))
+	for ccy := range items {
+		ccys = append(ccys, ccy)
+	}
+	sort.Strings(ccys)
+
+	result := make([]DustSweepItem, 0, len(ccys))
+	for _, ccy := range ccys {
+		item := items[ccy]
+		c.sweepDust(item, toCcy, opts)
+		if item.Err != nil {
+			fmt.Printf("[Dust] %s 清理失败: %v\n", ccy, item.Err)
+		} else if item.Skipped != "" {
+			fmt.Printf("[Dust] %s 跳过: %s\n", ccy, item.Skipped)
+		} else {
+			fmt.Printf("[Dust] %s %.8f -> %s %.8f，估值 %.8f\n", ccy, item.Trading+item.Funding, toCcy, item.Received, item.Value)
+		}
+		result = append(result, *item)
+	}
+	return result, nil
+}
+
+func (c *OKXClient) sweepDust(item *DustSweepItem, toCcy string, opts DustSweepOptions) {
+	total := item.Trading + item.Funding
+
+	pairs, err := c.GetConvertCurrencyPair(item.Ccy, toCcy)
+	if err != nil {
+		item.Err = err
+		return
+	}
+	if len(pairs.Data) == 0 {
+		item.Skipped = "不支持闪兑"
+		return
+	}
+	pair := pairs.Data[0]
+	if min, _ := strconv.ParseFloat(pair.BaseCcyMin, 64); pair.BaseCcy == item.Ccy && total < min {
+		item.Skipped = fmt.Sprintf("低于闪兑最小数量 %s", pair.BaseCcyMin)
+		return
+	}
+
+	quote, err := c.convertQuote(pair, item.Ccy, total)
+	if err != nil {
+		item.Err = err
+		return
+	}
+	item.Value, _ = strconv.ParseFloat(quote.QuoteSz, 64)
+	if pair.BaseCcy != item.Ccy {
+		item.Value, _ = strconv.ParseFloat(quote.BaseSz, 64)
+	}
+	if item.Value >= opts.Threshold {
+		item.Skipped = fmt.Sprintf("估值 %.8f 不低于阈值 %.8f", item.Value, opts.Threshold)
+		return
+	}
+	if opts.DryRun {
+		return
+	}
+
+	if item.Trading > 0 {
+		_, err = c.AssetTransfer(AssetTransferRequest{
+			Ccy:      item.Ccy,
+			Amt:      strconv.FormatFloat(item.Trading, 'f', -1, 64),
+			From:     string(OkxAccountTrading),
+			To:       string(OkxAccountFunding),
+			ClientId: "dust" + strconv.FormatInt(time.Now().UnixMilli(), 10),
+		})
+		if err != nil {
+			item.Err = err
+			return
+		}
+		// 划转后重新询价，避免报价过期
+		quote, err = c.convertQuote(pair, item.Ccy, total)
+		if err != nil {
+			item.Err = err
+			return
+		}
+	}
+
+	trade, err := c.ConvertTrade(ConvertTradeRequest{
+		QuoteId:  quote.QuoteId,
+		BaseCcy:  quote.BaseCcy,
+		QuoteCcy: quote.QuoteCcy,
+		Side:     quote.Side,
+		Sz:       quote.RfqSz,
+		SzCcy:    quote.RfqSzCcy,
+		ClTReqId: "dust" + strconv.FormatInt(time.Now().UnixMilli(), 10),
+	})
+	if err != nil {
+		item.Err = err
+		return
+	}
+	if len(trade.Data) == 0 || trade.Data[0].State != "fullyFilled" {
+		item.Err = fmt.Errorf("闪兑交易未成交")
+		return
+	}
+	item.TradeId = trade.Data[0].TradeId
+	if pair.BaseCcy == item.Ccy {
+		item.Received, _ = strconv.ParseFloat(trade.Data[0].FillQuoteSz, 64)
+	} else {
+		item.Received, _ = strconv.ParseFloat(trade.Data[0].FillBaseSz, 64)
+	}
+}
+
+// convertQuote 询价卖出 sz 数量的 ccy，ccy 可以是币对中的交易货币或计价货币
+func (c *OKXClient) convertQuote(pair ConvertCurrencyPairData, ccy string, sz float64) (*ConvertEstimateQuoteData, error) {
+	side := "sell"
+	if pair.BaseCcy != ccy {
+		side = "buy"
+	}
+	quote, err := c.ConvertEstimateQuote(ConvertEstimateQuoteRequest{
+		BaseCcy:  pair.BaseCcy,
+		QuoteCcy: pair.QuoteCcy,
+		Side:     side,
+		RfqSz:    strconv.FormatFloat(sz, 'f', -1, 64),
+		RfqSzCcy: ccy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(quote.Data) == 0 {
+		return nil, fmt.Errorf("闪兑预估询价无数据")
+	}
+	return &quote.Data[0], nil
+}
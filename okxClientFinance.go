@@ -3,6 +3,9 @@ package galatvtr
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
+	"time"
 )
 
 // GetSavingsBalance 获取余币宝余额
@@ -54,3 +57,205 @@ func (c *OKXClient) SavingsPurchaseRedempt(request SavingsPurchaseRedemptRequest
 
 	return &result, nil
 }
+
+// GetLendingRateSummary 获取市场借贷信息（公共），ccy 为空时返回全部币种
+func (c *OKXClient) GetLendingRateSummary(ccy string) (*LendingRateSummaryResponse, error) {
+	endpoint := okxQuery("/api/v5/finance/savings/lending-rate-summary", "ccy", ccy)
+
+	// 使用无认证请求，因为这是公共接口
+	resp, _, err := c.SendRequestNoAuth("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result LendingRateSummaryResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取市场借贷信息失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetLendingRateHistory 获取市场借贷历史（公共），after/before 为毫秒时间戳分页参数，limit 最大 100
+func (c *OKXClient) GetLendingRateHistory(ccy, after, before, limit string) (*LendingRateHistoryResponse, error) {
+	endpoint := okxQuery("/api/v5/finance/savings/lending-rate-history",
+		"ccy", ccy, "after", after, "before", before, "limit", limit)
+
+	// 使用无认证请求，因为这是公共接口
+	resp, _, err := c.SendRequestNoAuth("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result LendingRateHistoryResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取市场借贷历史失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetLendingHistory 获取余币宝出借明细，after/before 为毫秒时间戳分页参数，limit 最大 100
+func (c *OKXClient) GetLendingHistory(ccy, after, before, limit string) (*LendingHistoryResponse, error) {
+	endpoint := okxQuery("/api/v5/finance/savings/lending-history",
+		"ccy", ccy, "after", after, "before", before, "limit", limit)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result LendingHistoryResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取出借明细失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// SetLendingRate 设置余币宝借贷利率
+func (c *OKXClient) SetLendingRate(request SetLendingRateRequest) (*SetLendingRateResponse, error) {
+	endpoint := "/api/v5/finance/savings/set-lending-rate"
+
+	// 打印请求参数
+	fmt.Printf("设置借贷利率参数: %+v\n", request)
+
+	resp, err := c.SendRequest("POST", endpoint, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SetLendingRateResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("设置借贷利率失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// SavingsInterestReport 余币宝收益统计
+type SavingsInterestReport struct {
+	Ccy      string  // 币种
+	Earnings float64 // 统计区间内的利息收入
+	AvgAmt   float64 // 统计区间内按时间加权的平均出借数量，两条记录之间按前一条记录的数量计算
+	AvgRate  float64 // 统计区间内按出借数量加权的平均出借年利率
+	APY      float64 // 按利息收入和平均出借数量折算的实际年化收益率
+	Records  int     // 出借记录条数
+	StartMs  int64   // 统计开始时间，Unix 毫秒时间戳
+	EndMs    int64   // 统计结束时间，Unix 毫秒时间戳
+}
+
+// lendingPoint 单条出借记录的时间和数量
+type lendingPoint struct {
+	ts  int64
+	amt float64
+}
+
+// GetSavingsInterestReport 统计 [startMs, endMs] 区间内各币种的余币宝利息收入和实际年化收益率，ccy 为空时统计全部币种
+func (c *OKXClient) GetSavingsInterestReport(ccy string, startMs, endMs int64) (map[string]*SavingsInterestReport, error) {
+	if endMs <= startMs {
+		return nil, fmt.Errorf("结束时间必须大于开始时间")
+	}
+
+	reports := make(map[string]*SavingsInterestReport)
+	weightedRate := make(map[string]float64)
+	points := make(map[string][]lendingPoint)
+	seen := make(map[string]bool)
+	after := strconv.FormatInt(endMs+1, 10)
+	for {
+		result, err := c.GetLendingHistory(ccy, after, "", "100")
+		if err != nil {
+			return nil, err
+		}
+		if len(result.Data) == 0 {
+			break
+		}
+
+		reachedStart := false
+		var lastTs int64
+		for _, data := range result.Data {
+			ts, err := strconv.ParseInt(data.Ts, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("解析出借时间失败: %v", err)
+			}
+			lastTs = ts
+			if ts < startMs {
+				reachedStart = true
+				break
+			}
+			// 翻页游标与上一页最后一条记录的时间重叠，按币种和时间去重
+			key := data.Ccy + ":" + data.Ts
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			values, err := parseFloats([]string{data.Amt, data.Earnings, data.Rate})
+			if err != nil {
+				return nil, err
+			}
+
+			report, ok := reports[data.Ccy]
+			if !ok {
+				report = &SavingsInterestReport{Ccy: data.Ccy, StartMs: startMs, EndMs: endMs}
+				reports[data.Ccy] = report
+			}
+			report.Records++
+			report.Earnings += values[1]
+			weightedRate[data.Ccy] += values[0] * values[2]
+			points[data.Ccy] = append(points[data.Ccy], lendingPoint{ts: ts, amt: values[0]})
+		}
+		if reachedStart || len(result.Data) < 100 {
+			break
+		}
+
+		// after 不包含游标本身，用 lastTs+1 使同一毫秒内未返回的其它币种记录出现在下一页；
+		// 整页记录都在同一毫秒时游标无法前进，只能跳过该毫秒
+		next := strconv.FormatInt(lastTs+1, 10)
+		if next == after {
+			next = strconv.FormatInt(lastTs, 10)
+		}
+		after = next
+	}
+
+	window := float64(endMs - startMs)
+	years := window / float64(365*24*time.Hour/time.Millisecond)
+	for ccy, report := range reports {
+		// 记录按时间倒序返回，按时间升序计算每条记录持续到下一条记录（或统计结束）的时长
+		list := points[ccy]
+		sort.Slice(list, func(i, j int) bool { return list[i].ts < list[j].ts })
+		var totalAmt, amtMs float64
+		for i, point := range list {
+			until := endMs
+			if i+1 < len(list) {
+				until = list[i+1].ts
+			}
+			totalAmt += point.amt
+			amtMs += point.amt * float64(until-point.ts)
+		}
+		report.AvgAmt = amtMs / window
+		if totalAmt > 0 {
+			report.AvgRate = weightedRate[ccy] / totalAmt
+		}
+		if report.AvgAmt > 0 && years > 0 {
+			report.APY = report.Earnings / report.AvgAmt / years
+		}
+	}
+	return reports, nil
+}
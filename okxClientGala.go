@@ -1,159 +1,147 @@
 package galatvtr
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"time"
 )
 
+// ZhuanbiRedemptionAllToAccountBalance 将稳定赚币全部赎回到资金账户，再将资金账户可用余额全部划转到交易账户。
+// 部分到账或超时时仍会划转已到账的部分，但返回错误，调用方不应按全部到账处理
 func (c *OKXClient) ZhuanbiRedemptionAllToAccountBalance(ticker string) error {
-	instId, err := ConvertTvTrickerToSingleCoinName(ticker)
+	ccy, err := ConvertTvTrickerToSingleCoinName(ticker)
 	if err != nil {
 		fmt.Printf("[Redemption] 转换交易对失败: %v\n", err)
 		return err
 	}
 
-	var assetBalance float64
-	getAssetBalanceResult, err := c.GetAssetBalance(instId)
+	assetBalance, err := c.getFundingAvailBal(ccy)
 	if err != nil {
 		fmt.Printf("[Redemption] 查询资金账户余额失败: %v\n", err)
 		return err
 	}
-	for _, data := range getAssetBalanceResult.Data {
-		if data.Ccy == instId {
-			if valueFloat, err := strconv.ParseFloat(data.AvailBal, 64); err == nil {
-				assetBalance = valueFloat
-			}
-		}
-	}
 
-	getSavingsBalanceResult, err := c.GetSavingsBalance(instId)
+	savingBalance, err := c.getSavingsAmt(ccy)
 	if err != nil {
 		fmt.Printf("[Redemption] 查询稳定赚币余额失败: %v\n", err)
 		return err
 	}
-	var savingBalance float64
-	for _, data := range getSavingsBalanceResult.Data {
-		if data.Ccy == instId {
-			if valueFloat, err := strconv.ParseFloat(data.Amt, 64); err == nil {
-				savingBalance = valueFloat
-			}
-		}
-	}
-
-	if savingBalance > 0 {
-		request := SavingsPurchaseRedemptRequest{
-			Ccy:  instId,
-			Side: "redempt",
-			Amt:  strconv.FormatFloat(zhuanbiFormatFloat(instId, savingBalance), 'f', 8, 64),
-		}
-		// 赎回到资金账户
-		fmt.Printf("[Redemption] 开始从稳定赚币赎回...\n")
-		_, errS := c.SavingsPurchaseRedempt(request)
-		if errS == nil {
-			// 等待赎回成功，一直查询资金账户直到余额变化
-			fmt.Printf("[Redemption] 等待赎回到账，监控资金账户余额变化...\n")
-			maxRetries := 4 // 最多等待60次，每次间隔500毫秒，总共30秒
-			for i := 0; i < maxRetries; i++ {
-				time.Sleep(500 * time.Millisecond) // 等待500毫秒
-
-				// 查询当前资金账户余额
-				currentAssetBalanceResult, err := c.GetAssetBalance(instId)
-				if err != nil {
-					fmt.Printf("[Redemption] 第%d次查询资金账户余额失败: %v\n", i+1, err)
-					continue
-				}
 
-				var currentAssetBalance float64
-				for _, data := range currentAssetBalanceResult.Data {
-					if data.Ccy == instId {
-						if valueFloat, err := strconv.ParseFloat(data.AvailBal, 64); err == nil {
-							currentAssetBalance = valueFloat
-						}
-					}
-				}
-
-				fmt.Printf("[Redemption] 第%d次查询，当前资金账户余额: %.8f，原余额: %.8f\n", i+1, currentAssetBalance, assetBalance)
-
-				// 如果余额有增加，说明赎回已到账
-				if currentAssetBalance > assetBalance {
-					fmt.Printf("[Redemption] 检测到余额增加，赎回已到账\n")
-					assetBalance = currentAssetBalance
-					break
-				}
-			}
-		}
+	result, err := c.RedeemSavingsToTrading(context.Background(), ticker, assetBalance, savingBalance, RedemptionOptions{TransferPartial: true})
+	if err != nil {
+		return err
 	}
+	return result.incomplete()
+}
 
-	transferRequest := AssetTransferRequest{
-		Ccy:  instId,
-		Amt:  strconv.FormatFloat(zhuanbiFormatFloat(instId, assetBalance), 'f', 8, 64),
-		From: "6",  // 资金账户
-		To:   "18", // 交易账户
+// GalaZhuanbiRedemptionAllToAccountBalance 使用调用方已查询的余额执行赎回和划转，返回等待结束时的资金账户余额。
+// 部分到账或超时时仍会划转已到账的部分并返回余额，同时返回错误
+func (c *OKXClient) GalaZhuanbiRedemptionAllToAccountBalance(ticker string, assetBalance, savingBalance float64) (float64, error) {
+	result, err := c.RedeemSavingsToTrading(context.Background(), ticker, assetBalance, savingBalance, RedemptionOptions{TransferPartial: true})
+	if result == nil {
+		return assetBalance, err
 	}
-	_, errT := c.AssetTransfer(transferRequest)
-	if errT != nil {
-		fmt.Printf("[Redemption] 资金划转失败: %v\n", errT)
-		return errT
+	if err == nil {
+		err = result.incomplete()
 	}
-
-	return nil
+	return result.Balance, err
 }
 
-func (c *OKXClient) GalaZhuanbiRedemptionAllToAccountBalance(ticker string, assetBalance, savingBalance float64) (float64, error) {
-	instId, err := ConvertTvTrickerToSingleCoinName(ticker)
+// RedeemSavingsToTrading 将 savingBalance 从稳定赚币赎回到资金账户，按 opts 等待到账，
+// 再将资金账户可用余额划转到交易账户。赎回申请失败时直接返回错误，不再继续划转；
+// 部分到账或超时且未设置 TransferPartial 时不划转，并返回错误
+func (c *OKXClient) RedeemSavingsToTrading(ctx context.Context, ticker string, assetBalance, savingBalance float64, opts RedemptionOptions) (*RedemptionResult, error) {
+	ccy, err := ConvertTvTrickerToSingleCoinName(ticker)
 	if err != nil {
 		fmt.Printf("[Redemption] 转换交易对失败: %v\n", err)
-		return assetBalance, err
+		return nil, err
+	}
+
+	result := &RedemptionResult{
+		Ccy:       ccy,
+		Status:    RedemptionSkipped,
+		Requested: zhuanbiFormatFloat(ccy, savingBalance),
+		Balance:   assetBalance,
 	}
 
-	if savingBalance > 0 {
+	if result.Requested > 0 {
 		request := SavingsPurchaseRedemptRequest{
-			Ccy:  instId,
+			Ccy:  ccy,
 			Side: "redempt",
-			Amt:  strconv.FormatFloat(zhuanbiFormatFloat(instId, savingBalance), 'f', 8, 64),
+			Amt:  strconv.FormatFloat(result.Requested, 'f', 8, 64),
 		}
 		// 赎回到资金账户
 		fmt.Printf("[Redemption] 开始从稳定赚币赎回...\n")
-		_, errS := c.SavingsPurchaseRedempt(request)
-		if errS == nil {
-			// 等待赎回成功，一直查询资金账户直到余额变化
-			fmt.Printf("[Redemption] 等待赎回到账，监控资金账户余额变化...\n")
-			maxRetries := 4 // 最多等待60次，每次间隔500毫秒，总共30秒
-			for i := 0; i < maxRetries; i++ {
-				time.Sleep(500 * time.Millisecond) // 等待500毫秒
-
-				// 查询当前资金账户余额
-				currentAssetBalance, assetBalanceErr := c.GalaGetAssetBalance(ticker)
-				if assetBalanceErr != nil {
-					return assetBalance, assetBalanceErr
-				}
-
-				fmt.Printf("[Redemption] 第%d次查询，当前资金账户余额: %.8f，原余额: %.8f\n", i+1, currentAssetBalance, assetBalance)
+		if _, err := c.SavingsPurchaseRedempt(request); err != nil {
+			fmt.Printf("[Redemption] 稳定赚币赎回失败: %v\n", err)
+			return result, err
+		}
 
-				// 如果余额有增加，说明赎回已到账
-				if currentAssetBalance > assetBalance {
-					fmt.Printf("[Redemption] 检测到余额增加，赎回已到账\n")
-					assetBalance = currentAssetBalance
-					break
-				}
-			}
+		// 等待赎回到账，监控资金账户余额变化
+		fmt.Printf("[Redemption] 等待赎回到账，监控资金账户余额变化...\n")
+		start := time.Now()
+		status, balance, err := waitForRedemption(ctx, assetBalance, result.Requested, opts, func() (float64, error) {
+			return c.getFundingAvailBal(ccy)
+		})
+		result.Status = status
+		result.Balance = balance
+		result.Arrived = balance - assetBalance
+		result.Elapsed = time.Since(start)
+		fmt.Printf("[Redemption] 赎回结果: %s，申请 %.8f，到账 %.8f，耗时 %s\n", result.Status, result.Requested, result.Arrived, result.Elapsed)
+		if err != nil {
+			return result, err
+		}
+		if status != RedemptionRedeemed && !opts.TransferPartial {
+			return result, fmt.Errorf("赎回未全部到账: %s，申请 %.8f，到账 %.8f", status, result.Requested, result.Arrived)
 		}
 	}
 
+	amt := zhuanbiFormatFloat(ccy, result.Balance)
+	if amt <= 0 {
+		return result, nil
+	}
 	transferRequest := AssetTransferRequest{
-		Ccy:  instId,
-		Amt:  strconv.FormatFloat(zhuanbiFormatFloat(instId, assetBalance), 'f', 8, 64),
-		From: "6",  // 资金账户
-		To:   "18", // 交易账户
+		Ccy:  ccy,
+		Amt:  strconv.FormatFloat(amt, 'f', 8, 64),
+		From: string(OkxAccountFunding),
+		To:   string(OkxAccountTrading),
 	}
-	_, errT := c.AssetTransfer(transferRequest)
-	if errT != nil {
-		fmt.Printf("[Redemption] 资金划转失败: %v\n", errT)
-		return assetBalance, errT
+	if _, err := c.AssetTransfer(transferRequest); err != nil {
+		fmt.Printf("[Redemption] 资金划转失败: %v\n", err)
+		return result, err
 	}
+	result.Transferred = amt
 
-	return assetBalance, nil
+	return result, nil
+}
+
+// getFundingAvailBal 获取资金账户指定币种的可用余额
+func (c *OKXClient) getFundingAvailBal(ccy string) (float64, error) {
+	result, err := c.GetAssetBalance(ccy)
+	if err != nil {
+		return 0, err
+	}
+	for _, data := range result.Data {
+		if data.Ccy == ccy {
+			return strconv.ParseFloat(data.AvailBal, 64)
+		}
+	}
+	return 0, nil
+}
+
+// getSavingsAmt 获取稳定赚币指定币种的持有数量
+func (c *OKXClient) getSavingsAmt(ccy string) (float64, error) {
+	result, err := c.GetSavingsBalance(ccy)
+	if err != nil {
+		return 0, err
+	}
+	for _, data := range result.Data {
+		if data.Ccy == ccy {
+			return strconv.ParseFloat(data.Amt, 64)
+		}
+	}
+	return 0, nil
 }
 
 func (c *OKXClient) GalaGetTickerLast(instId string) (float64, error) {
@@ -246,3 +234,187 @@ func (c *OKXClient) GalaGetSavingBanlance(instId string) (float64, error) {
 	}
 	return savingBalance, nil
 }
+
+// 稳定赚币申购默认年利率，OKX 允许的最低值
+const defaultSavingsPurchaseRate = "0.01"
+
+// SweepOptions 闲置资金申购稳定赚币的参数
+type SweepOptions struct {
+	Reserve   float64 // 交易账户保留的数量，不参与申购
+	MinAmount float64 // 可申购数量低于该值时不执行
+	Rate      string  // 申购年利率，如 0.01 代表 1%，为空时使用 0.01
+	DryRun    bool    // 只计算并打印，不实际划转和申购
+}
+
+// SweepResult 闲置资金申购稳定赚币的结果
+type SweepResult struct {
+	Ccy          string  // 币种
+	TradingAvail float64 // 交易账户可用余额
+	Reserve      float64 // 保留数量
+	Amount       float64 // 计划申购数量
+	Transferred  bool    // 是否已划转到资金账户
+	Purchased    bool    // 是否已申购稳定赚币
+	DryRun       bool    // 是否为试运行
+}
+
+// SweepIdleToSavings 将交易账户中超出保留数量的可用余额划转到资金账户并申购稳定赚币，
+// 与 ZhuanbiRedemptionAllToAccountBalance 方向相反，用于平仓后让闲置资金继续生息
+func (c *OKXClient) SweepIdleToSavings(ccy string, opts SweepOptions) (*SweepResult, error) {
+	tradingAvail, err := c.getTradingAvailBal(ccy)
+	if err != nil {
+		fmt.Printf("[Sweep] 查询交易账户余额失败: %v\n", err)
+		return nil, err
+	}
+
+	result := &SweepResult{
+		Ccy:          ccy,
+		TradingAvail: tradingAvail,
+		Reserve:      opts.Reserve,
+		DryRun:       opts.DryRun,
+	}
+	if tradingAvail > opts.Reserve {
+		result.Amount = zhuanbiFormatFloat(ccy, tradingAvail-opts.Reserve)
+	}
+	if result.Amount <= 0 || result.Amount < opts.MinAmount {
+		fmt.Printf("[Sweep] %s 可申购数量 %.8f 不足，跳过\n", ccy, result.Amount)
+		result.Amount = 0
+		return result, nil
+	}
+
+	amt := strconv.FormatFloat(result.Amount, 'f', 8, 64)
+	if opts.DryRun {
+		fmt.Printf("[Sweep] 试运行：将 %s %s 从交易账户划转到资金账户并申购稳定赚币\n", amt, ccy)
+		return result, nil
+	}
+
+	_, err = c.AssetTransfer(AssetTransferRequest{
+		Ccy:  ccy,
+		Amt:  amt,
+		From: string(OkxAccountTrading),
+		To:   string(OkxAccountFunding),
+	})
+	if err != nil {
+		fmt.Printf("[Sweep] 资金划转失败: %v\n", err)
+		return result, err
+	}
+	result.Transferred = true
+
+	rate := opts.Rate
+	if rate == "" {
+		rate = defaultSavingsPurchaseRate
+	}
+	_, err = c.SavingsPurchaseRedempt(SavingsPurchaseRedemptRequest{
+		Ccy:  ccy,
+		Amt:  amt,
+		Side: "purchase",
+		Rate: rate,
+	})
+	if err != nil {
+		// 资金已在资金账户中，不影响后续赎回流程
+		fmt.Printf("[Sweep] 稳定赚币申购失败，资金留在资金账户: %v\n", err)
+		return result, err
+	}
+	result.Purchased = true
+
+	return result, nil
+}
+
+// getTradingAvailBal 获取交易账户指定币种的可用余额
+func (c *OKXClient) getTradingAvailBal(ccy string) (float64, error) {
+	result, err := c.GetAccountBalance(ccy)
+	if err != nil {
+		return 0, err
+	}
+	for _, data := range result.Data {
+		for _, detail := range data.Details {
+			if detail.Ccy == ccy {
+				return strconv.ParseFloat(detail.AvailBal, 64)
+			}
+		}
+	}
+	return 0, nil
+}
+
+// TotalAvailable 单个币种在各账户和理财产品中的分布
+type TotalAvailable struct {
+	Ccy           string  // 币种
+	Trading       float64 // 交易账户可用余额
+	Funding       float64 // 资金账户可用余额
+	Savings       float64 // 稳定赚币持有数量
+	Staking       float64 // 链上赚币活跃订单中投入的数量，赎回前处于锁定状态，不计入 Total
+	LiquidStaking float64 // ETH/SOL 质押凭证（BETH/OKSOL）持有数量，与原币并非 1:1 兑换，不计入 Total 和 Holdings
+	Total         float64 // 当前可用于交易的数量：Trading + Funding + Savings
+	Holdings      float64 // 总持有数量：Total + Staking
+}
+
+// GetTotalAvailable 汇总币种在交易账户、资金账户、稳定赚币和链上赚币中的数量，
+// Total 只包含可以立即使用的部分，锁定的链上赚币单独计入 Staking 和 Holdings
+func (c *OKXClient) GetTotalAvailable(ccy string) (*TotalAvailable, error) {
+	result := &TotalAvailable{Ccy: ccy}
+	var err error
+
+	if result.Trading, err = c.getTradingAvailBal(ccy); err != nil {
+		return nil, err
+	}
+	if result.Funding, err = c.getFundingAvailBal(ccy); err != nil {
+		return nil, err
+	}
+	if result.Savings, err = c.getSavingsAmt(ccy); err != nil {
+		return nil, err
+	}
+
+	orders, err := c.GetStakingDefiActiveOrders("", "", ccy, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, order := range orders.Data {
+		for _, invest := range order.InvestData {
+			if invest.Ccy != ccy {
+				continue
+			}
+			if valueFloat, err := strconv.ParseFloat(invest.Amt, 64); err == nil {
+				result.Staking += valueFloat
+			} else {
+				return nil, err
+			}
+		}
+	}
+
+	var balance *StakingBalanceResponse
+	switch ccy {
+	case "ETH":
+		balance, err = c.GetEthStakingBalance()
+	case "SOL":
+		balance, err = c.GetSolStakingBalance()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if balance != nil {
+		for _, data := range balance.Data {
+			if valueFloat, err := strconv.ParseFloat(data.Amt, 64); err == nil {
+				result.LiquidStaking += valueFloat
+			} else {
+				return nil, err
+			}
+		}
+	}
+
+	result.Total = result.Trading + result.Funding + result.Savings
+	result.Holdings = result.Total + result.Staking
+	return result, nil
+}
+
+// GalaGetTotalAvailable 按 TradingView 交易对查询基础币种当前可用于交易的数量，不含锁定的链上赚币
+func (c *OKXClient) GalaGetTotalAvailable(instId string) (float64, error) {
+	ccy, err := ConvertTvTrickerToSingleCoinName(instId)
+	if err != nil {
+		fmt.Printf("[Redemption] 转换交易对失败: %v\n", err)
+		return 0, err
+	}
+	result, err := c.GetTotalAvailable(ccy)
+	if err != nil {
+		return 0, err
+	}
+	return result.Total, nil
+}
@@ -3,6 +3,7 @@ package galatvtr
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 	"time"
 )
@@ -98,3 +99,71 @@ func (c *OKXClient) OkGetKlineFecher(symbol, interval string, startTime, endTime
 
 	return response.Data, nil
 }
+
+// GetCandles 获取最近 limit 根K线（按时间升序），只请求一次，失败时直接返回错误，limit 最大为 300
+func (c *OKXClient) GetCandles(instId, bar string, limit int) ([]Candle, error) {
+	if bar == "6H" || bar == "12H" || bar == "1D" || bar == "2D" || bar == "3D" || bar == "1W" || bar == "1M" || bar == "3M" {
+		bar = bar + "utc"
+	}
+	endpoint := okxQuery("/api/v5/market/candles", "instId", instId, "bar", bar, "limit", strconv.Itoa(limit))
+
+	// 使用无认证请求，因为这是公共接口
+	resp, statusCode, err := c.SendRequestNoAuth("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("获取K线数据失败: HTTP %d", statusCode)
+	}
+
+	var result HttpKlineResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return nil, fmt.Errorf("获取K线数据失败: %s", result.Msg)
+	}
+
+	return ParseOkxCandles(result.Data)
+}
+
+// GetMarketTrades 获取交易产品最近的公共成交数据，limit 最大为 500
+func (c *OKXClient) GetMarketTrades(instId string, limit int) ([]MarketTrade, error) {
+	endpoint := okxQuery("/api/v5/market/trades", "instId", instId, "limit", strconv.Itoa(limit))
+
+	// 使用无认证请求，因为这是公共接口
+	resp, statusCode, err := c.SendRequestNoAuth("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("获取成交数据失败: HTTP %d", statusCode)
+	}
+
+	var result MarketTradesResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return nil, fmt.Errorf("获取成交数据失败: %s", result.Msg)
+	}
+
+	trades := make([]MarketTrade, 0, len(result.Data))
+	for _, trade := range result.Data {
+		values, err := parseFloats([]string{trade.Px, trade.Sz, trade.Ts})
+		if err != nil {
+			return nil, err
+		}
+		trades = append(trades, MarketTrade{
+			TradeId: trade.TradeId,
+			InstId:  trade.InstId,
+			Side:    trade.Side,
+			Px:      values[0],
+			Sz:      values[1],
+			Ts:      int64(values[2]),
+		})
+	}
+	return trades, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 )
 
 // GetInstruments 获取交易产品基础信息
@@ -46,3 +47,49 @@ func (c *OKXClient) GetInstruments(instType, instId string) (float64, error) {
 		return 0, fmt.Errorf("获取交易产品基础信息失败: %s", result.Msg)
 	}
 }
+
+// GetInstrument 获取单个交易产品的完整基础信息
+func (c *OKXClient) GetInstrument(instType, instId string) (*InstrumentData, error) {
+	if instType == "" || instId == "" {
+		return nil, fmt.Errorf("instType 和 instId 参数不能为空")
+	}
+	endpoint := okxQuery("/api/v5/public/instruments", "instType", instType, "instId", instId)
+
+	// 使用无认证请求，因为这是公共接口
+	resp, statusCode, err := c.SendRequestNoAuth("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("获取交易产品基础信息失败: HTTP %d", statusCode)
+	}
+
+	var result InstrumentsResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return nil, fmt.Errorf("获取交易产品基础信息失败: %s", result.Msg)
+	}
+	if len(result.Data) == 0 {
+		return nil, fmt.Errorf("未找到交易产品: %s", instId)
+	}
+
+	return &result.Data[0], nil
+}
+
+// okxInstType 根据产品ID推断产品类型，如 BTC-USDT-SWAP 为 SWAP，BTC-USD-250328 为 FUTURES，BTC-USDT 为 MARGIN
+func okxInstType(instId string) string {
+	parts := strings.Split(instId, "-")
+	switch {
+	case len(parts) == 3 && parts[2] == "SWAP":
+		return "SWAP"
+	case len(parts) == 3:
+		return "FUTURES"
+	case len(parts) > 3:
+		return "OPTION"
+	default:
+		return "MARGIN"
+	}
+}
@@ -0,0 +1,163 @@
+package galatvtr
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GetStakingDefiOffers 查看链上赚币项目，参数为空时不作筛选
+func (c *OKXClient) GetStakingDefiOffers(productId, protocolType, ccy string) (*StakingDefiOffersResponse, error) {
+	endpoint := okxQuery("/api/v5/finance/staking-defi/offers",
+		"productId", productId, "protocolType", protocolType, "ccy", ccy)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result StakingDefiOffersResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("查看链上赚币项目失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// StakingDefiPurchase 链上赚币申购
+func (c *OKXClient) StakingDefiPurchase(request StakingDefiPurchaseRequest) (*StakingDefiOrderResponse, error) {
+	// 打印请求参数
+	fmt.Printf("链上赚币申购参数: %+v\n", request)
+
+	return c.stakingDefiOrder("/api/v5/finance/staking-defi/purchase", request, "链上赚币申购失败")
+}
+
+// StakingDefiRedeem 链上赚币赎回
+func (c *OKXClient) StakingDefiRedeem(request StakingDefiRedeemRequest) (*StakingDefiOrderResponse, error) {
+	// 打印请求参数
+	fmt.Printf("链上赚币赎回参数: %+v\n", request)
+
+	return c.stakingDefiOrder("/api/v5/finance/staking-defi/redeem", request, "链上赚币赎回失败")
+}
+
+// StakingDefiCancel 撤销链上赚币申购/赎回
+func (c *OKXClient) StakingDefiCancel(request StakingDefiCancelRequest) (*StakingDefiOrderResponse, error) {
+	// 打印请求参数
+	fmt.Printf("链上赚币撤销参数: %+v\n", request)
+
+	return c.stakingDefiOrder("/api/v5/finance/staking-defi/cancel", request, "链上赚币撤销失败")
+}
+
+func (c *OKXClient) stakingDefiOrder(endpoint string, request interface{}, failMsg string) (*StakingDefiOrderResponse, error) {
+	resp, err := c.SendRequest("POST", endpoint, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var result StakingDefiOrderResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("%s: %s", failMsg, result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetStakingDefiActiveOrders 查看链上赚币活跃订单，参数为空时不作筛选
+func (c *OKXClient) GetStakingDefiActiveOrders(productId, protocolType, ccy, state string) (*StakingDefiActiveOrdersResponse, error) {
+	endpoint := okxQuery("/api/v5/finance/staking-defi/orders-active",
+		"productId", productId, "protocolType", protocolType, "ccy", ccy, "state", state)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result StakingDefiActiveOrdersResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("查看链上赚币活跃订单失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// EthStakingPurchase ETH 质押申购，申购后获得 BETH
+func (c *OKXClient) EthStakingPurchase(amt string) (*StakingAmtResponse, error) {
+	return c.stakingAmt("/api/v5/finance/staking-defi/eth/purchase", amt, "ETH 质押申购失败")
+}
+
+// EthStakingRedeem ETH 质押赎回，赎回 BETH 换回 ETH
+func (c *OKXClient) EthStakingRedeem(amt string) (*StakingAmtResponse, error) {
+	return c.stakingAmt("/api/v5/finance/staking-defi/eth/redeem", amt, "ETH 质押赎回失败")
+}
+
+// GetEthStakingBalance 获取 ETH 质押（BETH）余额
+func (c *OKXClient) GetEthStakingBalance() (*StakingBalanceResponse, error) {
+	return c.stakingBalance("/api/v5/finance/staking-defi/eth/balance", "获取 ETH 质押余额失败")
+}
+
+// SolStakingPurchase SOL 质押申购，申购后获得 OKSOL
+func (c *OKXClient) SolStakingPurchase(amt string) (*StakingAmtResponse, error) {
+	return c.stakingAmt("/api/v5/finance/staking-defi/sol/purchase", amt, "SOL 质押申购失败")
+}
+
+// SolStakingRedeem SOL 质押赎回，赎回 OKSOL 换回 SOL
+func (c *OKXClient) SolStakingRedeem(amt string) (*StakingAmtResponse, error) {
+	return c.stakingAmt("/api/v5/finance/staking-defi/sol/redeem", amt, "SOL 质押赎回失败")
+}
+
+// GetSolStakingBalance 获取 SOL 质押（OKSOL）余额
+func (c *OKXClient) GetSolStakingBalance() (*StakingBalanceResponse, error) {
+	return c.stakingBalance("/api/v5/finance/staking-defi/sol/balance", "获取 SOL 质押余额失败")
+}
+
+func (c *OKXClient) stakingAmt(endpoint, amt, failMsg string) (*StakingAmtResponse, error) {
+	request := StakingAmtRequest{Amt: amt}
+
+	// 打印请求参数
+	fmt.Printf("质押申购/赎回参数: %s %+v\n", endpoint, request)
+
+	resp, err := c.SendRequest("POST", endpoint, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var result StakingAmtResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("%s: %s", failMsg, result.Msg)
+	}
+
+	return &result, nil
+}
+
+func (c *OKXClient) stakingBalance(endpoint, failMsg string) (*StakingBalanceResponse, error) {
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result StakingBalanceResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("%s: %s", failMsg, result.Msg)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,244 @@
+package galatvtr
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// GetSubAccountList 查看子账户列表，subAcct 为空时返回全部，enable 为 true/false 时按状态筛选
+func (c *OKXClient) GetSubAccountList(subAcct, enable string) (*SubAccountListResponse, error) {
+	endpoint := okxQuery("/api/v5/users/subaccount/list", "enable", enable, "subAcct", subAcct)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SubAccountListResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("查看子账户列表失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetSubAccountTradingBalance 获取子账户交易账户余额
+func (c *OKXClient) GetSubAccountTradingBalance(subAcct string) (*BalanceResponse, error) {
+	if subAcct == "" {
+		return nil, fmt.Errorf("subAcct 参数不能为空")
+	}
+	endpoint := okxQuery("/api/v5/account/subaccount/balances", "subAcct", subAcct)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result BalanceResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取子账户交易账户余额失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetSubAccountFundingBalance 获取子账户资金账户余额，ccy 为空时返回全部币种
+func (c *OKXClient) GetSubAccountFundingBalance(subAcct, ccy string) (*AssetBalanceResponse, error) {
+	if subAcct == "" {
+		return nil, fmt.Errorf("subAcct 参数不能为空")
+	}
+	endpoint := okxQuery("/api/v5/asset/subaccount/balances", "subAcct", subAcct, "ccy", ccy)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AssetBalanceResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取子账户资金账户余额失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// SubAccountTransfer 子账户间资金划转
+func (c *OKXClient) SubAccountTransfer(request SubAccountTransferRequest) (*SubAccountTransferResponse, error) {
+	endpoint := "/api/v5/asset/subaccount/transfer"
+
+	// 打印请求参数
+	fmt.Printf("子账户间划转参数: %+v\n", request)
+
+	resp, err := c.SendRequest("POST", endpoint, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SubAccountTransferResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("子账户间划转失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetSubAccountApiKeys 查询子账户的 API Key，apiKey 为空时返回全部
+func (c *OKXClient) GetSubAccountApiKeys(subAcct, apiKey string) (*SubAccountApiKeyResponse, error) {
+	if subAcct == "" {
+		return nil, fmt.Errorf("subAcct 参数不能为空")
+	}
+	endpoint := okxQuery("/api/v5/users/subaccount/apikey", "subAcct", subAcct, "apiKey", apiKey)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SubAccountApiKeyResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("查询子账户 API Key 失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// OkxSubAccountView 通过母账户 API Key 查看和操作单个子账户
+type OkxSubAccountView struct {
+	client  *OKXClient
+	SubAcct string // 子账户名称
+}
+
+// SubAccount 获取子账户视图，client 必须使用母账户的 API Key 创建
+func (c *OKXClient) SubAccount(subAcct string) *OkxSubAccountView {
+	return &OkxSubAccountView{client: c, SubAcct: subAcct}
+}
+
+// Balance 获取子账户指定账户类型（资金账户或交易账户）中币种的可用余额
+func (v *OkxSubAccountView) Balance(account OkxAccount, ccy string) (float64, error) {
+	switch account {
+	case OkxAccountTrading:
+		result, err := v.client.GetSubAccountTradingBalance(v.SubAcct)
+		if err != nil {
+			return 0, err
+		}
+		for _, data := range result.Data {
+			for _, detail := range data.Details {
+				if detail.Ccy == ccy {
+					return strconv.ParseFloat(detail.AvailBal, 64)
+				}
+			}
+		}
+		return 0, nil
+	case OkxAccountFunding:
+		result, err := v.client.GetSubAccountFundingBalance(v.SubAcct, ccy)
+		if err != nil {
+			return 0, err
+		}
+		for _, data := range result.Data {
+			if data.Ccy == ccy {
+				return strconv.ParseFloat(data.AvailBal, 64)
+			}
+		}
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("不支持的账户类型: %s", account.DisplayName())
+	}
+}
+
+// TransferTo 从当前子账户的 from 账户划转到另一个子账户的 to 账户
+func (v *OkxSubAccountView) TransferTo(toSubAcct, ccy string, amt float64, from, to OkxAccount) (*SubAccountTransferResponse, error) {
+	return v.client.SubAccountTransfer(SubAccountTransferRequest{
+		Ccy:            ccy,
+		Amt:            strconv.FormatFloat(zhuanbiFormatFloat(ccy, amt), 'f', 8, 64),
+		From:           string(from),
+		To:             string(to),
+		FromSubAccount: v.SubAcct,
+		ToSubAccount:   toSubAcct,
+	})
+}
+
+// SubAccountRebalanceStep 子账户再平衡的单笔划转
+type SubAccountRebalanceStep struct {
+	FromSubAcct string  // 转出子账户
+	ToSubAcct   string  // 转入子账户
+	Amount      float64 // 划转数量
+	Done        bool    // 是否已执行，试运行时为 false
+}
+
+// RebalanceSubAccounts 按 targets 指定的目标数量在子账户的同类账户（资金账户或交易账户）之间调拨 ccy，
+// 从超出目标的子账户转给不足目标的子账户，超出部分不足以覆盖全部缺口时按子账户名称顺序优先满足
+func (c *OKXClient) RebalanceSubAccounts(ccy string, account OkxAccount, targets map[string]float64, dryRun bool) ([]SubAccountRebalanceStep, error) {
+	type balanceGap struct {
+		subAcct string
+		amount  float64
+	}
+	var surpluses, deficits []balanceGap
+
+	names := make([]string, 0, len(targets))
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		balance, err := c.SubAccount(name).Balance(account, ccy)
+		if err != nil {
+			return nil, err
+		}
+		gap := zhuanbiFormatFloat(ccy, balance-targets[name])
+		if gap > 0 {
+			surpluses = append(surpluses, balanceGap{name, gap})
+		} else if gap < 0 {
+			deficits = append(deficits, balanceGap{name, -gap})
+		}
+	}
+
+	var steps []SubAccountRebalanceStep
+	for i, j := 0, 0; i < len(surpluses) && j < len(deficits); {
+		amount := zhuanbiFormatFloat(ccy, math.Min(surpluses[i].amount, deficits[j].amount))
+		if amount > 0 {
+			step := SubAccountRebalanceStep{FromSubAcct: surpluses[i].subAcct, ToSubAcct: deficits[j].subAcct, Amount: amount}
+			if !dryRun {
+				if _, err := c.SubAccount(step.FromSubAcct).TransferTo(step.ToSubAcct, ccy, amount, account, account); err != nil {
+					return steps, err
+				}
+				step.Done = true
+			}
+			fmt.Printf("[Rebalance] %s -> %s %s %.8f，已执行: %v\n", step.FromSubAcct, step.ToSubAcct, ccy, amount, step.Done)
+			steps = append(steps, step)
+		}
+		surpluses[i].amount -= amount
+		deficits[j].amount -= amount
+		// 剩余数量低于精度时视为已用尽
+		if zhuanbiFormatFloat(ccy, surpluses[i].amount) <= 0 {
+			i++
+		}
+		if zhuanbiFormatFloat(ccy, deficits[j].amount) <= 0 {
+			j++
+		}
+	}
+	return steps, nil
+}
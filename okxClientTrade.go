@@ -9,6 +9,10 @@ import (
 func (c *OKXClient) PlaceOrder(order OrderRequestOkx) (*OrderResponse, error) {
 	endpoint := "/api/v5/trade/order"
 
+	if err := validateOrderAttachAlgoOrds(order); err != nil {
+		return nil, err
+	}
+
 	// 打印order
 	fmt.Printf("下单参数: %+v\n", order)
 
@@ -33,6 +37,10 @@ func (c *OKXClient) PlaceOrder(order OrderRequestOkx) (*OrderResponse, error) {
 func (c *OKXClient) PlaceOrderHeyueOkx(order OrderRequestOkx) (*OrderResponse, error) {
 	endpoint := "/api/v5/trade/order"
 
+	if err := validateOrderAttachAlgoOrds(order); err != nil {
+		return nil, err
+	}
+
 	// 打印order
 	fmt.Printf("下单参数: %+v\n", order)
 
@@ -87,6 +95,67 @@ func (c *OKXClient) GetOrderInfo(instId, ordId, clOrdId string) (*OrderInfoRespo
 	return &result, nil
 }
 
+// CancelOrder 撤单，ordId 和 clOrdId 必须传一个
+func (c *OKXClient) CancelOrder(instId, ordId, clOrdId string) (*OrderResponse, error) {
+	endpoint := "/api/v5/trade/cancel-order"
+
+	if ordId == "" && clOrdId == "" {
+		return nil, fmt.Errorf("ordId 和 clOrdId 至少需要提供一个")
+	}
+	request := map[string]string{"instId": instId}
+	if ordId != "" {
+		request["ordId"] = ordId
+	} else {
+		request["clOrdId"] = clOrdId
+	}
+
+	resp, err := c.SendRequest("POST", endpoint, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var result OrderResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		if len(result.Data) > 0 && result.Data[0].SMsg != "" {
+			return &result, fmt.Errorf("撤单失败: %s", result.Data[0].SMsg)
+		}
+		return &result, fmt.Errorf("撤单失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// AmendOrder 修改订单，可修改未成交订单的价格和数量，以及订单附带的止盈止损（包括订单成交后）
+func (c *OKXClient) AmendOrder(request AmendOrderRequest) (*AmendOrderResponse, error) {
+	endpoint := "/api/v5/trade/amend-order"
+
+	// 打印修改订单参数
+	fmt.Printf("修改订单参数: %+v\n", request)
+
+	resp, err := c.SendRequest("POST", endpoint, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AmendOrderResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		if len(result.Data) > 0 && result.Data[0].SMsg != "" {
+			return &result, fmt.Errorf("修改订单失败: %s", result.Data[0].SMsg)
+		}
+		return &result, fmt.Errorf("修改订单失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
 // PlaceAlgoOrder 策略委托下单
 func (c *OKXClient) PlaceAlgoOrder(order AlgoOrderRequest) (*AlgoOrderResponse, error) {
 	endpoint := "/api/v5/trade/order-algo"
@@ -111,38 +180,95 @@ func (c *OKXClient) PlaceAlgoOrder(order AlgoOrderRequest) (*AlgoOrderResponse,
 	return &result, nil
 }
 
-// GetAlgoOrderInfo 查询策略委托单信息
-// func (c *OKXClient) GetAlgoOrderInfo(apiKey, secretKey, passphrase string, isTestnet int, algoId, algoClOrdId string) (*galastruct.AlgoOrderInfoResponse, error) {
-// 	endpoint := "/api/v5/trade/order-algo"
-
-// 	// 构建查询参数
-// 	if algoId != "" {
-// 		endpoint += "?algoId=" + algoId
-// 	} else if algoClOrdId != "" {
-// 		endpoint += "?algoClOrdId=" + algoClOrdId
-// 	} else {
-// 		return nil, fmt.Errorf("algoId 和 algoClOrdId 至少需要提供一个")
-// 	}
-
-// 	resp, err := c.SendRequest(apiKey, secretKey, passphrase, isTestnet, "GET", endpoint, nil)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	var result galastruct.AlgoOrderInfoResponse
-// 	if err := json.Unmarshal(resp, &result); err != nil {
-// 		return nil, err
-// 	}
-
-// 	if result.Code != "0" {
-// 		if result.Msg == "Order does not exist" {
-// 			return nil, nil
-// 		}
-// 		return &result, fmt.Errorf("查询策略委托单信息失败: %s", result.Msg)
-// 	}
-
-// 	return &result, nil
-// }
+// AmendAlgoOrder 修改策略委托订单，仅支持未触发的止盈止损和计划委托
+func (c *OKXClient) AmendAlgoOrder(request AmendAlgoOrderRequest) (*AmendAlgoOrderResponse, error) {
+	endpoint := "/api/v5/trade/amend-algos"
+
+	// 打印修改策略委托参数
+	fmt.Printf("修改策略委托参数: %+v\n", request)
+
+	resp, err := c.SendRequest("POST", endpoint, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AmendAlgoOrderResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		if len(result.Data) > 0 && result.Data[0].SMsg != "" {
+			return &result, fmt.Errorf("修改策略委托失败: %s", result.Data[0].SMsg)
+		}
+		return &result, fmt.Errorf("修改策略委托失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetAlgoOrderInfo 查询策略委托单信息，订单不存在时返回 nil
+func (c *OKXClient) GetAlgoOrderInfo(algoId, algoClOrdId string) (*AlgoOrderInfoResponse, error) {
+	endpoint := "/api/v5/trade/order-algo"
+
+	// 构建查询参数
+	if algoId != "" {
+		endpoint += "?algoId=" + algoId
+	} else if algoClOrdId != "" {
+		endpoint += "?algoClOrdId=" + algoClOrdId
+	} else {
+		return nil, fmt.Errorf("algoId 和 algoClOrdId 至少需要提供一个")
+	}
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AlgoOrderInfoResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		if result.Msg == "Order does not exist" {
+			return nil, nil
+		}
+		return &result, fmt.Errorf("查询策略委托单信息失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
+
+// GetAlgoOrdersHistory 获取历史策略委托单列表，state 和 algoId 必须且只能传一个，
+// state 为 effective：已生效 canceled：已撤销 order_failed：委托失败
+func (c *OKXClient) GetAlgoOrdersHistory(ordType, state, algoId, instType, instId, after, before, limit string) (*AlgoOrderInfoResponse, error) {
+	if ordType == "" {
+		return nil, fmt.Errorf("ordType 参数不能为空")
+	}
+	if (state == "") == (algoId == "") {
+		return nil, fmt.Errorf("state 和 algoId 必须且只能提供一个")
+	}
+	endpoint := okxQuery("/api/v5/trade/orders-algo-history",
+		"ordType", ordType, "state", state, "algoId", algoId, "instType", instType, "instId", instId,
+		"after", after, "before", before, "limit", limit)
+
+	resp, err := c.SendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AlgoOrderInfoResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != "0" {
+		return &result, fmt.Errorf("获取历史策略委托单列表失败: %s", result.Msg)
+	}
+
+	return &result, nil
+}
 
 // GetAlgoOrdersPending 获取未完成策略委托单列表
 func (c *OKXClient) GetAlgoOrdersPending(ordType, instType, instId string) (*AlgoOrdersPendingResponse, error) {
@@ -0,0 +1,339 @@
+package galatvtr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultDcaLookbackDays = 30
+	dcaFillPollInterval    = 500 * time.Millisecond
+	dcaFillPollTimes       = 20
+)
+
+// DcaDip 跌幅加倍规则，当前价格较回看期内最高收盘价下跌超过 Drop 时，本期金额乘以 Multiplier
+type DcaDip struct {
+	Drop       float64 // 跌幅，如 0.2 表示下跌 20%
+	Multiplier float64 // 金额倍数，如 2 表示买入两倍金额
+}
+
+// DcaPlan 单个币种的定投计划
+type DcaPlan struct {
+	InstId          string   // 现货产品ID，如 BTC-USDT
+	Amount          float64  // 每期买入的计价货币数量
+	Dips            []DcaDip // 可选，跌幅加倍规则，满足多条时取倍数最大的一条
+	DipLookbackDays int      // 计算跌幅的回看天数，默认 30 天
+}
+
+// DcaConfig 定投配置
+type DcaConfig struct {
+	Schedule    string            // cron 表达式，如 "0 9 * * 1" 表示每周一 9:00
+	Location    *time.Location    // 解析 cron 的时区，默认本地时区
+	Plans       []DcaPlan         // 定投计划
+	Restake     bool              // 买入后是否将买到的币申购稳定赚币
+	RestakeRate string            // 申购年利率，为空时使用 0.01
+	Redemption  RedemptionOptions // 从稳定赚币赎回计价货币时的等待策略
+	RecordPath  string            // 每期记录追加写入的文件，每行一条 JSON，为空时不记录
+	DryRun      bool              // 只计算金额和资金调度，不实际赎回、下单和申购
+}
+
+// DcaRecord 单个币种单期定投的记录
+type DcaRecord struct {
+	Time       time.Time `json:"time"`
+	InstId     string    `json:"instId"`
+	BaseAmount float64   `json:"baseAmount"`       // 计划的每期金额
+	Drop       float64   `json:"drop"`             // 当前价格较回看期内最高收盘价的跌幅
+	Multiplier float64   `json:"multiplier"`       // 实际使用的金额倍数
+	Amount     float64   `json:"amount"`           // 本期买入的计价货币数量
+	Redeemed   float64   `json:"redeemed"`         // 本期从稳定赚币赎回的数量
+	OrdId      string    `json:"ordId,omitempty"`  // 买入订单ID
+	FilledSz   float64   `json:"filledSz"`         // 成交数量
+	AvgPx      float64   `json:"avgPx"`            // 成交均价
+	Fee        float64   `json:"fee"`              // 手续费，负数表示扣除
+	FeeCcy     string    `json:"feeCcy,omitempty"` // 手续费币种
+	Restaked   float64   `json:"restaked"`         // 申购稳定赚币的数量
+	DryRun     bool      `json:"dryRun,omitempty"` // 是否为试运行
+	Error      string    `json:"error,omitempty"`  // 失败原因
+}
+
+// DcaScheduler 定投调度器，按 cron 表达式定期从稳定赚币赎回所需的计价货币并市价买入
+type DcaScheduler struct {
+	client   *OKXClient
+	config   DcaConfig
+	schedule *CronSchedule
+}
+
+// NewDcaScheduler 创建定投调度器
+func NewDcaScheduler(client *OKXClient, config DcaConfig) (*DcaScheduler, error) {
+	schedule, err := ParseCron(config.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	if len(config.Plans) == 0 {
+		return nil, fmt.Errorf("定投计划不能为空")
+	}
+	for _, plan := range config.Plans {
+		if len(strings.Split(plan.InstId, "-")) != 2 {
+			return nil, fmt.Errorf("定投只支持现货产品: %s", plan.InstId)
+		}
+		if plan.Amount <= 0 {
+			return nil, fmt.Errorf("%s 每期金额必须大于 0", plan.InstId)
+		}
+	}
+	if config.Location == nil {
+		config.Location = time.Local
+	}
+	return &DcaScheduler{client: client, config: config, schedule: schedule}, nil
+}
+
+// Run 按计划循环执行定投，直到 ctx 结束
+func (s *DcaScheduler) Run(ctx context.Context) error {
+	for {
+		next := s.schedule.Next(time.Now().In(s.config.Location))
+		if next.IsZero() {
+			return fmt.Errorf("cron 表达式 %q 没有下一次执行时间", s.config.Schedule)
+		}
+		fmt.Printf("[DCA] 下一次定投时间: %s\n", next.Format("2006-01-02 15:04"))
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		s.RunCycle(ctx)
+	}
+}
+
+// RunCycle 立即执行一期定投，单个计划失败不影响其它计划，失败原因记录在 DcaRecord.Error 中
+func (s *DcaScheduler) RunCycle(ctx context.Context) []DcaRecord {
+	records := make([]DcaRecord, 0, len(s.config.Plans))
+	for _, plan := range s.config.Plans {
+		record := s.runPlan(ctx, plan)
+		if record.Error != "" {
+			fmt.Printf("[DCA] %s 定投失败: %s\n", plan.InstId, record.Error)
+		}
+		if err := s.appendRecord(record); err != nil {
+			fmt.Printf("[DCA] 写入定投记录失败: %v\n", err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func (s *DcaScheduler) runPlan(ctx context.Context, plan DcaPlan) DcaRecord {
+	parts := strings.Split(plan.InstId, "-")
+	base, quote := parts[0], parts[1]
+	record := DcaRecord{
+		Time:       time.Now(),
+		InstId:     plan.InstId,
+		BaseAmount: plan.Amount,
+		Multiplier: 1,
+		DryRun:     s.config.DryRun,
+	}
+
+	if len(plan.Dips) > 0 {
+		drop, err := s.priceDrop(plan)
+		if err != nil {
+			// 行情获取失败时按原金额定投
+			fmt.Printf("[DCA] %s 计算跌幅失败，按原金额定投: %v\n", plan.InstId, err)
+		} else {
+			record.Drop = drop
+			record.Multiplier = dipMultiplier(plan.Dips, drop)
+		}
+	}
+	record.Amount = zhuanbiFormatFloat(quote, plan.Amount*record.Multiplier)
+	if record.Amount <= 0 {
+		record.Error = "买入金额为 0"
+		return record
+	}
+
+	route, err := s.client.RouteFundsToTrading(ctx, quote, record.Amount, FundRouteConfig{
+		Redemption: s.config.Redemption,
+		DryRun:     s.config.DryRun,
+	})
+	if err != nil {
+		record.Error = fmt.Sprintf("资金调度失败: %v", err)
+		return record
+	}
+	for _, step := range route.Steps {
+		if step.Source == FundSourceSavings {
+			record.Redeemed += step.Amount
+		}
+	}
+	if s.config.DryRun {
+		fmt.Printf("[DCA] 试运行：%s 买入 %.2f %s，倍数 %.2f，需赎回 %.8f\n",
+			plan.InstId, record.Amount, quote, record.Multiplier, record.Redeemed)
+		return record
+	}
+	if !route.Satisfied {
+		record.Error = fmt.Sprintf("%s 可用资金不足: 需要 %.8f，可用 %.8f", quote, record.Amount, route.Routed)
+		return record
+	}
+
+	order, err := s.client.PlaceOrder(OrderRequestOkx{
+		InstID:  plan.InstId,
+		TdMode:  "cash",
+		Side:    "buy",
+		OrdType: "market",
+		Sz:      strconv.FormatFloat(record.Amount, 'f', -1, 64),
+		TgtCcy:  "quote_ccy",
+		ClOrdID: "dca" + strconv.FormatInt(time.Now().UnixMilli(), 10),
+	})
+	if err != nil {
+		record.Error = fmt.Sprintf("下单失败: %v", err)
+		return record
+	}
+	if len(order.Data) == 0 {
+		record.Error = "下单失败: 未返回订单ID"
+		return record
+	}
+	record.OrdId = order.Data[0].OrdID
+
+	if err := s.waitFilled(ctx, &record); err != nil {
+		record.Error = err.Error()
+		return record
+	}
+	fmt.Printf("[DCA] %s 买入 %.8f，均价 %.8f，花费 %.2f %s\n",
+		plan.InstId, record.FilledSz, record.AvgPx, record.Amount, quote)
+
+	if s.config.Restake {
+		if err := s.restake(base, &record); err != nil {
+			record.Error = fmt.Sprintf("申购稳定赚币失败: %v", err)
+		}
+	}
+	return record
+}
+
+// priceDrop 计算最新收盘价较回看期内最高收盘价的跌幅
+func (s *DcaScheduler) priceDrop(plan DcaPlan) (float64, error) {
+	lookback := plan.DipLookbackDays
+	if lookback <= 0 {
+		lookback = defaultDcaLookbackDays
+	}
+	data, err := s.client.OkGetKlineFecher(plan.InstId, "1D", nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	candles, err := ParseOkxCandles(data)
+	if err != nil {
+		return 0, err
+	}
+	if len(candles) == 0 {
+		return 0, fmt.Errorf("未获取到K线")
+	}
+	if len(candles) > lookback {
+		candles = candles[len(candles)-lookback:]
+	}
+	var high float64
+	for _, candle := range candles {
+		high = math.Max(high, candle.Close)
+	}
+	last := candles[len(candles)-1].Close
+	if high <= 0 || last >= high {
+		return 0, nil
+	}
+	return 1 - last/high, nil
+}
+
+// dipMultiplier 返回满足跌幅条件的规则中最大的倍数，没有满足的规则时为 1
+func dipMultiplier(dips []DcaDip, drop float64) float64 {
+	multiplier := 1.0
+	for _, dip := range dips {
+		if drop >= dip.Drop && dip.Multiplier > multiplier {
+			multiplier = dip.Multiplier
+		}
+	}
+	return multiplier
+}
+
+// waitFilled 轮询订单直到完全成交或撤销，填充成交数量、均价和手续费
+func (s *DcaScheduler) waitFilled(ctx context.Context, record *DcaRecord) error {
+	for i := 0; i < dcaFillPollTimes; i++ {
+		info, err := s.client.GetOrderInfo(record.InstId, record.OrdId, "")
+		if err == nil && len(info.Data) > 0 {
+			data := info.Data[0]
+			record.FilledSz = parseRiskFloat(data.AccFillSz)
+			record.AvgPx = parseRiskFloat(data.AvgPx)
+			record.Fee = parseRiskFloat(data.Fee)
+			record.FeeCcy = data.FeeCcy
+			switch data.State {
+			case "filled":
+				return nil
+			case "canceled", "mmp_canceled":
+				if record.FilledSz > 0 {
+					return nil
+				}
+				return fmt.Errorf("订单 %s 已撤销且未成交", record.OrdId)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(dcaFillPollInterval):
+		}
+	}
+	if record.FilledSz > 0 {
+		return nil
+	}
+	return fmt.Errorf("订单 %s 等待成交超时", record.OrdId)
+}
+
+// restake 将本期买到的币（扣除以该币收取的手续费）划转到资金账户并申购稳定赚币
+func (s *DcaScheduler) restake(ccy string, record *DcaRecord) error {
+	net := record.FilledSz
+	if record.FeeCcy == ccy {
+		net += record.Fee
+	}
+	amount := zhuanbiFormatFloat(ccy, net)
+	if amount <= 0 {
+		fmt.Printf("[DCA] %s 可申购数量 %.8f 不足，跳过\n", ccy, net)
+		return nil
+	}
+	if err := s.client.transferBetween(ccy, amount, OkxAccountTrading, OkxAccountFunding); err != nil {
+		return err
+	}
+
+	rate := s.config.RestakeRate
+	if rate == "" {
+		rate = defaultSavingsPurchaseRate
+	}
+	_, err := s.client.SavingsPurchaseRedempt(SavingsPurchaseRedemptRequest{
+		Ccy:  ccy,
+		Amt:  strconv.FormatFloat(amount, 'f', 8, 64),
+		Side: "purchase",
+		Rate: rate,
+	})
+	if err != nil {
+		// 资金已在资金账户中，下期赎回不受影响
+		return err
+	}
+	record.Restaked = amount
+	return nil
+}
+
+// appendRecord 将定投记录追加到 RecordPath
+func (s *DcaScheduler) appendRecord(record DcaRecord) error {
+	if s.config.RecordPath == "" {
+		return nil
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(s.config.RecordPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = file.Write(append(data, '\n'))
+	return err
+}
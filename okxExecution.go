@@ -0,0 +1,124 @@
+package galatvtr
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// OkxExecVenue 在 OKX 上执行拆单，现货市价单按交易货币数量下单
+type OkxExecVenue struct {
+	Client   *OKXClient
+	InstType string // 产品类型 SPOT/MARGIN/SWAP/FUTURES，为空时按 instId 推断，币币对在 cash 模式下为 SPOT、否则为 MARGIN
+	TdMode   string // 交易模式 cash/isolated/cross，默认 cash
+	PosSide  string // 开平仓模式下的持仓方向 long/short
+}
+
+func (v *OkxExecVenue) instType(instId string) string {
+	if v.InstType != "" {
+		return v.InstType
+	}
+	instType := okxInstType(instId)
+	// 币币对在 cash 模式下是现货，否则市价买单会把 Sz 当作计价货币数量
+	if instType == "MARGIN" && (v.TdMode == "" || v.TdMode == "cash") {
+		return "SPOT"
+	}
+	return instType
+}
+
+func (v *OkxExecVenue) Instrument(instId string) (ExecInstrument, error) {
+	instrument, err := v.Client.GetInstrument(v.instType(instId), instId)
+	if err != nil {
+		return ExecInstrument{}, err
+	}
+	values, err := parseFloats([]string{instrument.LotSz, instrument.MinSz, instrument.TickSz})
+	if err != nil {
+		return ExecInstrument{}, err
+	}
+	result := ExecInstrument{LotSz: values[0], MinSz: values[1], TickSz: values[2], CtVal: 1}
+	// 现货和币币杠杆没有合约面值
+	if ctVal, err := strconv.ParseFloat(instrument.CtVal, 64); err == nil && ctVal > 0 {
+		result.CtVal = ctVal
+	}
+	return result, nil
+}
+
+func (v *OkxExecVenue) LastPrice(instId string) (float64, error) {
+	last, err := v.Client.GetTickerLast(instId)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(last, 64)
+}
+
+// TradedVolume 按最近 500 笔公共成交统计，成交过于活跃时会少算
+func (v *OkxExecVenue) TradedVolume(instId string, sinceMs int64) (float64, error) {
+	trades, err := v.Client.GetMarketTrades(instId, 500)
+	if err != nil {
+		return 0, err
+	}
+	return sumTradedVolume(trades, sinceMs), nil
+}
+
+func (v *OkxExecVenue) PlaceChild(order ExecChildOrder) (string, error) {
+	tdMode := v.TdMode
+	if tdMode == "" {
+		tdMode = "cash"
+	}
+	request := OrderRequestOkx{
+		InstID:  order.InstId,
+		TdMode:  tdMode,
+		Side:    order.Side,
+		PosSide: v.PosSide,
+		OrdType: "market",
+		Sz:      strconv.FormatFloat(order.Sz, 'f', -1, 64),
+		ClOrdID: "exec" + strconv.FormatInt(time.Now().UnixNano(), 10),
+	}
+	if order.Px > 0 {
+		request.OrdType = "limit"
+		request.Px = strconv.FormatFloat(order.Px, 'f', -1, 64)
+	} else if v.instType(order.InstId) == "SPOT" {
+		request.TgtCcy = "base_ccy"
+	}
+	result, err := v.Client.PlaceOrder(request)
+	if err != nil {
+		return "", err
+	}
+	if len(result.Data) == 0 || result.Data[0].OrdID == "" {
+		return "", fmt.Errorf("下单失败: 未返回订单ID")
+	}
+	return result.Data[0].OrdID, nil
+}
+
+func (v *OkxExecVenue) ChildStatus(instId, orderId string) (ExecChildStatus, error) {
+	result, err := v.Client.GetOrderInfo(instId, orderId, "")
+	if err != nil {
+		return ExecChildStatus{}, err
+	}
+	if len(result.Data) == 0 {
+		return ExecChildStatus{}, fmt.Errorf("未查询到订单: %s", orderId)
+	}
+	order := result.Data[0]
+	status := ExecChildStatus{
+		Done: order.State == "filled" || order.State == "canceled" || order.State == "mmp_canceled",
+	}
+	status.Filled, _ = strconv.ParseFloat(order.AccFillSz, 64)
+	status.AvgPx, _ = strconv.ParseFloat(order.AvgPx, 64)
+	return status, nil
+}
+
+func (v *OkxExecVenue) CancelChild(instId, orderId string) error {
+	_, err := v.Client.CancelOrder(instId, orderId, "")
+	return err
+}
+
+// sumTradedVolume 统计 sinceMs 之后的成交数量
+func sumTradedVolume(trades []MarketTrade, sinceMs int64) float64 {
+	var volume float64
+	for _, trade := range trades {
+		if trade.Ts > sinceMs {
+			volume += trade.Sz
+		}
+	}
+	return volume
+}
@@ -0,0 +1,203 @@
+package galatvtr
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// FundSource 资金来源，按 FundRouteConfig.Priority 的顺序依次使用
+type FundSource string
+
+const (
+	FundSourceTrading FundSource = "trading" // 交易账户已有的可用余额
+	FundSourceFunding FundSource = "funding" // 资金账户可用余额，划转到交易账户
+	FundSourceSavings FundSource = "savings" // 稳定赚币，赎回到资金账户后再划转到交易账户
+)
+
+// 默认资金来源优先级
+var defaultFundPriority = []FundSource{FundSourceTrading, FundSourceFunding, FundSourceSavings}
+
+// FundRouteConfig 资金调度配置
+type FundRouteConfig struct {
+	Priority   []FundSource      // 资金来源优先级，为空时依次使用交易账户、资金账户、稳定赚币
+	Redemption RedemptionOptions // 稳定赚币赎回的等待策略
+	DryRun     bool              // 只计算调度步骤，不实际划转和赎回
+}
+
+// FundRouteStep 资金调度的单个步骤
+type FundRouteStep struct {
+	Source    FundSource // 资金来源
+	From      OkxAccount // 转出账户
+	To        OkxAccount // 转入账户
+	Available float64    // 该来源的可用数量
+	Amount    float64    // 本步骤使用的数量
+	Done      bool       // 是否已执行，试运行时为 false
+	Err       error      // 执行失败的原因
+}
+
+// FundRouteResult 资金调度结果
+type FundRouteResult struct {
+	Ccy       string          // 币种
+	Target    float64         // 交易账户目标可用数量
+	Routed    float64         // 交易账户已具备的数量（含原有余额和本次划转）
+	Satisfied bool            // 是否已满足目标数量
+	Steps     []FundRouteStep // 调度步骤
+}
+
+// RouteFundsToTrading 使交易账户中 ccy 的可用数量达到 target，按优先级依次从各来源补足差额，
+// 每个来源只划转仍然缺少的数量。来源不足时不返回错误，由 Satisfied 标识是否满足
+func (c *OKXClient) RouteFundsToTrading(ctx context.Context, ccy string, target float64, config FundRouteConfig) (*FundRouteResult, error) {
+	priority := config.Priority
+	if len(priority) == 0 {
+		priority = defaultFundPriority
+	}
+
+	result := &FundRouteResult{Ccy: ccy, Target: target}
+
+	tradingAvail, err := c.getTradingAvailBal(ccy)
+	if err != nil {
+		return nil, err
+	}
+	// 交易账户的已有余额始终计入，Priority 中的 trading 只影响步骤记录的位置
+	result.Routed = tradingAvail
+
+	for _, source := range priority {
+		need := target - result.Routed
+		if need <= 0 {
+			break
+		}
+
+		var step FundRouteStep
+		switch source {
+		case FundSourceTrading:
+			step = FundRouteStep{Source: source, From: OkxAccountTrading, To: OkxAccountTrading, Available: tradingAvail, Done: true}
+		case FundSourceFunding:
+			step, err = c.routeFromFunding(ccy, need, config.DryRun)
+		case FundSourceSavings:
+			step, err = c.routeFromSavings(ctx, ccy, need, config)
+		default:
+			return result, fmt.Errorf("不支持的资金来源: %s", source)
+		}
+
+		if step.Amount > 0 || step.Err != nil {
+			fmt.Printf("[Route] %s -> %s %s %.8f，可用 %.8f，已执行: %v\n", step.From.DisplayName(), step.To.DisplayName(), ccy, step.Amount, step.Available, step.Done)
+		}
+		result.Steps = append(result.Steps, step)
+		if step.Done || config.DryRun {
+			result.Routed += step.Amount
+		}
+		if err != nil {
+			return result, err
+		}
+	}
+
+	result.Satisfied = result.Routed >= target
+	return result, nil
+}
+
+func (c *OKXClient) routeFromFunding(ccy string, need float64, dryRun bool) (FundRouteStep, error) {
+	step := FundRouteStep{Source: FundSourceFunding, From: OkxAccountFunding, To: OkxAccountTrading}
+
+	available, err := c.getFundingAvailBal(ccy)
+	if err != nil {
+		step.Err = err
+		return step, err
+	}
+	step.Available = available
+	step.Amount = routeAmount(ccy, need, available)
+	if step.Amount <= 0 || dryRun {
+		return step, nil
+	}
+
+	err = c.transferBetween(ccy, step.Amount, OkxAccountFunding, OkxAccountTrading)
+	if err != nil {
+		step.Err = err
+		return step, err
+	}
+	step.Done = true
+	return step, nil
+}
+
+func (c *OKXClient) routeFromSavings(ctx context.Context, ccy string, need float64, config FundRouteConfig) (FundRouteStep, error) {
+	step := FundRouteStep{Source: FundSourceSavings, From: OkxAccountEarn, To: OkxAccountTrading}
+
+	available, err := c.getSavingsAmt(ccy)
+	if err != nil {
+		step.Err = err
+		return step, err
+	}
+	step.Available = available
+	step.Amount = routeAmount(ccy, need, available)
+	if step.Amount <= 0 || config.DryRun {
+		return step, nil
+	}
+
+	fundingBalance, err := c.getFundingAvailBal(ccy)
+	if err != nil {
+		step.Err = err
+		return step, err
+	}
+
+	_, err = c.SavingsPurchaseRedempt(SavingsPurchaseRedemptRequest{
+		Ccy:  ccy,
+		Side: "redempt",
+		Amt:  strconv.FormatFloat(step.Amount, 'f', 8, 64),
+	})
+	if err != nil {
+		step.Err = err
+		return step, err
+	}
+
+	status, balance, err := waitForRedemption(ctx, fundingBalance, step.Amount, config.Redemption, func() (float64, error) {
+		return c.getFundingAvailBal(ccy)
+	})
+	if err != nil {
+		step.Err = err
+		return step, err
+	}
+	arrived := zhuanbiFormatFloat(ccy, math.Min(balance-fundingBalance, step.Amount))
+	if status != RedemptionRedeemed {
+		fmt.Printf("[Route] 稳定赚币赎回未全部到账: %s，申请 %.8f，到账 %.8f\n", status, step.Amount, arrived)
+		if arrived <= 0 {
+			step.Amount = 0
+			step.Err = fmt.Errorf("稳定赚币赎回未到账: %s", status)
+			return step, step.Err
+		}
+		step.Amount = arrived
+	}
+
+	err = c.transferBetween(ccy, step.Amount, OkxAccountFunding, OkxAccountTrading)
+	if err != nil {
+		step.Err = err
+		return step, err
+	}
+	step.Done = true
+	return step, nil
+}
+
+// routeAmount 计算从某个来源划转的数量，向上取整以补足差额，但不超过该来源的可用数量
+func routeAmount(ccy string, need, available float64) float64 {
+	amount := zhuanbiCeilFloat(ccy, need)
+	if amount > available {
+		amount = zhuanbiFormatFloat(ccy, available)
+	}
+	return amount
+}
+
+// transferBetween 在主账户的资金账户和交易账户之间划转
+func (c *OKXClient) transferBetween(ccy string, amount float64, from, to OkxAccount) error {
+	if from != OkxAccountFunding && from != OkxAccountTrading || to != OkxAccountFunding && to != OkxAccountTrading {
+		return fmt.Errorf("不支持的划转账户: %s -> %s", from.DisplayName(), to.DisplayName())
+	}
+	_, err := c.AssetTransfer(AssetTransferRequest{
+		Ccy:      ccy,
+		Amt:      strconv.FormatFloat(amount, 'f', 8, 64),
+		From:     string(from),
+		To:       string(to),
+		ClientId: "route" + strconv.FormatInt(time.Now().UnixMilli(), 10),
+	})
+	return err
+}
@@ -0,0 +1,68 @@
+package galatvtr
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// IsAlgoOrderTriggered 查询策略委托是否已触发，订单不存在时返回错误
+func (c *OKXClient) IsAlgoOrderTriggered(algoId, algoClOrdId string) (bool, error) {
+	result, err := c.GetAlgoOrderInfo(algoId, algoClOrdId)
+	if err != nil {
+		return false, err
+	}
+	if result == nil || len(result.Data) == 0 {
+		return false, fmt.Errorf("策略委托单不存在: %s%s", algoId, algoClOrdId)
+	}
+	return result.Data[0].State == "effective", nil
+}
+
+// MoveStopLoss 找到持仓对应的未触发止盈止损单（conditional 和 oco），将止损触发价修改为 slTriggerPx，
+// posSide 为空时不按持仓方向筛选，返回修改成功的策略委托单ID
+func (c *OKXClient) MoveStopLoss(instId, posSide, slTriggerPx string) ([]string, error) {
+	var moved []string
+	for _, ordType := range []string{"conditional", "oco"} {
+		pending, err := c.GetAlgoOrdersPending(ordType, "", instId)
+		if err != nil {
+			return moved, err
+		}
+		for _, order := range pending.Data {
+			if order.SlTriggerPx == "" || posSide != "" && order.PosSide != posSide {
+				continue
+			}
+			_, err := c.AmendAlgoOrder(AmendAlgoOrderRequest{
+				InstId:         instId,
+				AlgoId:         order.AlgoId,
+				NewSlTriggerPx: slTriggerPx,
+				NewSlOrdPx:     marketOrdPx(order.SlOrdPx),
+			})
+			if err != nil {
+				return moved, err
+			}
+			fmt.Printf("[MoveStop] %s %s 止损 %s -> %s\n", instId, order.AlgoId, order.SlTriggerPx, slTriggerPx)
+			moved = append(moved, order.AlgoId)
+		}
+	}
+	if len(moved) == 0 {
+		return nil, fmt.Errorf("未找到 %s %s 的止损单", instId, posSide)
+	}
+	return moved, nil
+}
+
+// MoveStopToBreakEven 将持仓的止损移动到开仓均价
+func (c *OKXClient) MoveStopToBreakEven(instId, posSide string) ([]string, error) {
+	positions, err := c.GetPositions("", instId)
+	if err != nil {
+		return nil, err
+	}
+	for _, position := range positions.Data {
+		if posSide != "" && position.PosSide != posSide {
+			continue
+		}
+		if avgPx, _ := strconv.ParseFloat(position.AvgPx, 64); avgPx <= 0 {
+			continue
+		}
+		return c.MoveStopLoss(instId, posSide, position.AvgPx)
+	}
+	return nil, fmt.Errorf("未找到 %s %s 的持仓", instId, posSide)
+}
@@ -0,0 +1,82 @@
+package galatvtr
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// OkxStopExecutor 使用 OKX 止盈止损策略委托（conditional）执行 StopManager 的止损单，修改止损时直接改单
+type OkxStopExecutor struct {
+	Client *OKXClient
+}
+
+// NewOkxStopManager 创建使用 OKX 策略委托的止损管理器
+func NewOkxStopManager(client *OKXClient, path string) (*StopManager, error) {
+	return NewStopManager(&OkxStopExecutor{Client: client}, path)
+}
+
+func (e *OkxStopExecutor) LastPrice(instId string) (float64, error) {
+	last, err := e.Client.GetTickerLast(instId)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(last, 64)
+}
+
+func (e *OkxStopExecutor) Candles(instId, interval string, limit int) ([]Candle, error) {
+	return e.Client.GetCandles(instId, interval, limit)
+}
+
+func (e *OkxStopExecutor) PlaceStop(stop ManagedStop) (string, error) {
+	side := "sell"
+	if stop.Direction == "short" {
+		side = "buy"
+	}
+	result, err := e.Client.PlaceAlgoOrder(AlgoOrderRequest{
+		InstId:      stop.InstId,
+		TdMode:      stop.TdMode,
+		Side:        side,
+		PosSide:     stop.PosSide,
+		OrdType:     "conditional",
+		Sz:          stop.Sz,
+		ReduceOnly:  stop.TdMode != "cash",
+		SlTriggerPx: strconv.FormatFloat(stop.StopPx, 'f', -1, 64),
+		SlOrdPx:     "-1",
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(result.Data) == 0 || result.Data[0].AlgoId == "" {
+		return "", fmt.Errorf("止损单下单失败: 未返回策略委托单ID")
+	}
+	return result.Data[0].AlgoId, nil
+}
+
+func (e *OkxStopExecutor) MoveStop(stop ManagedStop, stopPx float64) (string, error) {
+	_, err := e.Client.AmendAlgoOrder(AmendAlgoOrderRequest{
+		InstId:         stop.InstId,
+		AlgoId:         stop.OrderId,
+		NewSlTriggerPx: strconv.FormatFloat(stopPx, 'f', -1, 64),
+		NewSlOrdPx:     "-1",
+	})
+	if err != nil {
+		return "", err
+	}
+	return stop.OrderId, nil
+}
+
+func (e *OkxStopExecutor) CancelStop(stop ManagedStop) error {
+	_, err := e.Client.CancelAlgoOrders([]CancelAlgoOrderRequest{{AlgoId: stop.OrderId, InstId: stop.InstId}})
+	return err
+}
+
+func (e *OkxStopExecutor) StopActive(stop ManagedStop) (bool, error) {
+	result, err := e.Client.GetAlgoOrderInfo(stop.OrderId, "")
+	if err != nil {
+		return false, err
+	}
+	if result == nil || len(result.Data) == 0 {
+		return false, nil
+	}
+	return result.Data[0].State == "live", nil
+}
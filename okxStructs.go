@@ -18,15 +18,32 @@ type OrderRequestOkx struct {
 
 // AttachAlgoOrd 下单附带止盈止损信息
 type AttachAlgoOrd struct {
-	// AttachAlgoClOrdId string `json:"attachAlgoClOrdId,omitempty"` // 客户自定义的策略订单ID
-	TpTriggerPx string `json:"tpTriggerPx,omitempty"` // 止盈触发价
-	TpOrdPx     string `json:"tpOrdPx,omitempty"`     // 止盈委托价
-	// TpOrdKind         string `json:"tpOrdKind,omitempty"`         // 止盈订单类型
-	// SlTriggerPx       string `json:"slTriggerPx,omitempty"`       // 止损触发价
-	// SlOrdPx           string `json:"slOrdPx,omitempty"`           // 止损委托价
-	// TpTriggerPxType   string `json:"tpTriggerPxType,omitempty"`   // 止盈触发价类型
-	// SlTriggerPxType   string `json:"slTriggerPxType,omitempty"`   // 止损触发价类型
-	// Sz                string `json:"sz,omitempty"`                // 数量
+	AttachAlgoClOrdId    string `json:"attachAlgoClOrdId,omitempty"`    // 客户自定义的策略订单ID
+	TpTriggerPx          string `json:"tpTriggerPx,omitempty"`          // 止盈触发价
+	TpOrdPx              string `json:"tpOrdPx,omitempty"`              // 止盈委托价，-1 为市价
+	TpOrdKind            string `json:"tpOrdKind,omitempty"`            // 止盈订单类型 condition：条件单 limit：限价单
+	SlTriggerPx          string `json:"slTriggerPx,omitempty"`          // 止损触发价
+	SlOrdPx              string `json:"slOrdPx,omitempty"`              // 止损委托价，-1 为市价
+	TpTriggerPxType      string `json:"tpTriggerPxType,omitempty"`      // 止盈触发价类型 last：最新价格 index：指数价格 mark：标记价格
+	SlTriggerPxType      string `json:"slTriggerPxType,omitempty"`      // 止损触发价类型 last：最新价格 index：指数价格 mark：标记价格
+	Sz                   string `json:"sz,omitempty"`                   // 数量，仅适用于分批止盈
+	AmendPxOnTriggerType string `json:"amendPxOnTriggerType,omitempty"` // 是否启用开仓价止损 0：不开启 1：开启，仅适用于分批止盈的止损
+}
+
+// AttachAlgoOrdInfo 订单附带的止盈止损信息
+type AttachAlgoOrdInfo struct {
+	AttachAlgoId      string `json:"attachAlgoId"`      // 附带止盈止损的订单ID
+	AttachAlgoClOrdId string `json:"attachAlgoClOrdId"` // 客户自定义的策略订单ID
+	TpTriggerPx       string `json:"tpTriggerPx"`       // 止盈触发价
+	TpTriggerPxType   string `json:"tpTriggerPxType"`   // 止盈触发价类型
+	TpOrdPx           string `json:"tpOrdPx"`           // 止盈委托价
+	TpOrdKind         string `json:"tpOrdKind"`         // 止盈订单类型
+	SlTriggerPx       string `json:"slTriggerPx"`       // 止损触发价
+	SlTriggerPxType   string `json:"slTriggerPxType"`   // 止损触发价类型
+	SlOrdPx           string `json:"slOrdPx"`           // 止损委托价
+	Sz                string `json:"sz"`                // 数量
+	FailCode          string `json:"failCode"`          // 委托失败时的错误码
+	FailReason        string `json:"failReason"`        // 委托失败的原因
 }
 
 // OrderResponse 下单响应
@@ -74,7 +91,14 @@ type BalanceResponse struct {
 	Code string `json:"code"`
 	Msg  string `json:"msg"`
 	Data []struct {
-		Details []struct {
+		TotalEq     string `json:"totalEq"`     // 美金层面权益
+		AdjEq       string `json:"adjEq"`       // 美金层面有效保证金
+		Imr         string `json:"imr"`         // 美金层面占用保证金
+		Mmr         string `json:"mmr"`         // 美金层面维持保证金
+		MgnRatio    string `json:"mgnRatio"`    // 美金层面保证金率
+		NotionalUsd string `json:"notionalUsd"` // 以美金价值为单位的持仓数量
+		UTime       string `json:"uTime"`       // 账户信息的更新时间
+		Details     []struct {
 			Ccy       string `json:"ccy"`
 			AvailEq   string `json:"availEq"`
 			AvailBal  string `json:"availBal"`
@@ -464,6 +488,8 @@ type OrderInfoResponse struct {
 		AlgoId       string `json:"algoId"`
 		UTime        string `json:"uTime"`
 		CTime        string `json:"cTime"`
+
+		AttachAlgoOrds []AttachAlgoOrdInfo `json:"attachAlgoOrds"` // 附带的止盈止损信息
 	} `json:"data"`
 }
 
@@ -560,3 +586,917 @@ type OrdersHistoryArchiveResponse struct {
 	Msg  string              `json:"msg"`
 	Data []OrderHistoryData `json:"data"`
 }
+
+// LendingRateSummaryData 市场借贷信息（公共）数据
+type LendingRateSummaryData struct {
+	Ccy       string `json:"ccy"`       // 币种，如 BTC
+	AvgAmt    string `json:"avgAmt"`    // 24小时平均借贷量
+	AvgAmtUsd string `json:"avgAmtUsd"` // 24小时平均借贷美元价值
+	AvgRate   string `json:"avgRate"`   // 24小时平均借出利率
+	PreRate   string `json:"preRate"`   // 上一次借贷年利率
+	EstRate   string `json:"estRate"`   // 下一次预估借贷年利率
+}
+
+// LendingRateSummaryResponse 市场借贷信息（公共）响应
+type LendingRateSummaryResponse struct {
+	Code string                   `json:"code"`
+	Msg  string                   `json:"msg"`
+	Data []LendingRateSummaryData `json:"data"`
+}
+
+// LendingRateHistoryData 市场借贷历史（公共）数据
+type LendingRateHistoryData struct {
+	Ccy  string `json:"ccy"`  // 币种，如 BTC
+	Amt  string `json:"amt"`  // 市场总出借数量
+	Rate string `json:"rate"` // 出借年利率
+	Ts   string `json:"ts"`   // 时间，Unix 毫秒时间戳
+}
+
+// LendingRateHistoryResponse 市场借贷历史（公共）响应
+type LendingRateHistoryResponse struct {
+	Code string                   `json:"code"`
+	Msg  string                   `json:"msg"`
+	Data []LendingRateHistoryData `json:"data"`
+}
+
+// LendingHistoryData 出借明细数据
+type LendingHistoryData struct {
+	Ccy      string `json:"ccy"`      // 币种，如 BTC
+	Amt      string `json:"amt"`      // 出借数量
+	Earnings string `json:"earnings"` // 已赚取利息
+	Rate     string `json:"rate"`     // 出借年利率
+	Ts       string `json:"ts"`       // 出借时间，Unix 毫秒时间戳
+}
+
+// LendingHistoryResponse 出借明细响应
+type LendingHistoryResponse struct {
+	Code string               `json:"code"`
+	Msg  string               `json:"msg"`
+	Data []LendingHistoryData `json:"data"`
+}
+
+// SetLendingRateRequest 设置余币宝借贷利率请求
+type SetLendingRateRequest struct {
+	Ccy  string `json:"ccy"`  // 币种名称，如 BTC
+	Rate string `json:"rate"` // 贷出年利率，如 0.01 代表 1%
+}
+
+// SetLendingRateResponse 设置余币宝借贷利率响应
+type SetLendingRateResponse struct {
+	Code string                  `json:"code"`
+	Msg  string                  `json:"msg"`
+	Data []SetLendingRateRequest `json:"data"`
+}
+
+// StakingDefiInvestData 链上赚币投资信息
+type StakingDefiInvestData struct {
+	Ccy    string `json:"ccy"`              // 投资币种，如 BTC
+	Amt    string `json:"amt,omitempty"`    // 投资数量，申购和查询订单时返回
+	Bal    string `json:"bal,omitempty"`    // 可投资数量，仅查询项目时返回
+	MinAmt string `json:"minAmt,omitempty"` // 最小申购量，仅查询项目时返回
+	MaxAmt string `json:"maxAmt,omitempty"` // 最大可申购量，仅查询项目时返回
+}
+
+// StakingDefiEarningData 链上赚币收益信息
+type StakingDefiEarningData struct {
+	Ccy         string `json:"ccy"`         // 收益币种
+	EarningType string `json:"earningType"` // 收益类型 0：预估收益 1：累计发放收益
+	Earnings    string `json:"earnings"`    // 收益数量，仅查询订单时返回
+}
+
+// StakingDefiOfferData 链上赚币项目数据
+type StakingDefiOfferData struct {
+	Ccy                      string                   `json:"ccy"`                      // 币种名称
+	ProductId                string                   `json:"productId"`                // 项目ID
+	Protocol                 string                   `json:"protocol"`                 // 项目名称
+	ProtocolType             string                   `json:"protocolType"`             // 项目类型 defi：链上赚币
+	Term                     string                   `json:"term"`                     // 项目期限，0 为活期
+	Apy                      string                   `json:"apy"`                      // 预估年化收益率
+	EarlyRedeem              bool                     `json:"earlyRedeem"`              // 项目是否支持提前赎回
+	State                    string                   `json:"state"`                    // 项目状态 purchasable/sold_out/stop
+	RedeemPeriod             []string                 `json:"redeemPeriod"`             // 赎回期
+	FastRedemptionDailyLimit string                   `json:"fastRedemptionDailyLimit"` // 快速赎回每日最高额度
+	InvestData               []StakingDefiInvestData  `json:"investData"`               // 当前可投资信息
+	EarningData              []StakingDefiEarningData `json:"earningData"`              // 收益信息
+}
+
+// StakingDefiOffersResponse 查看链上赚币项目响应
+type StakingDefiOffersResponse struct {
+	Code string                 `json:"code"`
+	Msg  string                 `json:"msg"`
+	Data []StakingDefiOfferData `json:"data"`
+}
+
+// StakingDefiPurchaseRequest 链上赚币申购请求
+type StakingDefiPurchaseRequest struct {
+	ProductId  string                  `json:"productId"`      // 项目ID
+	InvestData []StakingDefiInvestData `json:"investData"`     // 投资信息
+	Term       string                  `json:"term,omitempty"` // 投资期限，定期项目必填
+	Tag        string                  `json:"tag,omitempty"`  // 订单标签
+}
+
+// StakingDefiRedeemRequest 链上赚币赎回请求
+type StakingDefiRedeemRequest struct {
+	OrdId            string `json:"ordId"`                      // 订单ID
+	ProtocolType     string `json:"protocolType"`               // 项目类型 defi：链上赚币
+	AllowEarlyRedeem bool   `json:"allowEarlyRedeem,omitempty"` // 是否提前赎回
+}
+
+// StakingDefiCancelRequest 链上赚币撤销申购/赎回请求
+type StakingDefiCancelRequest struct {
+	OrdId        string `json:"ordId"`        // 订单ID
+	ProtocolType string `json:"protocolType"` // 项目类型 defi：链上赚币
+}
+
+// StakingDefiOrderResponse 链上赚币申购/赎回/撤销响应
+type StakingDefiOrderResponse struct {
+	Code string `json:"code"`
+	Msg  string `json:"msg"`
+	Data []struct {
+		OrdId string `json:"ordId"` // 订单ID
+		Tag   string `json:"tag"`   // 订单标签
+	} `json:"data"`
+}
+
+// StakingDefiActiveOrderData 链上赚币活跃订单数据
+type StakingDefiActiveOrderData struct {
+	Ccy                      string                   `json:"ccy"`                      // 币种名称
+	OrdId                    string                   `json:"ordId"`                    // 订单ID
+	ProductId                string                   `json:"productId"`                // 项目ID
+	State                    string                   `json:"state"`                    // 订单状态 8：待上车 13：撤销中 9：上链中 1：收益中 2：赎回中
+	Protocol                 string                   `json:"protocol"`                 // 项目名称
+	ProtocolType             string                   `json:"protocolType"`             // 项目类型
+	Term                     string                   `json:"term"`                     // 项目期限，0 为活期
+	Apy                      string                   `json:"apy"`                      // 预估年化收益率
+	EarlyRedeem              bool                     `json:"earlyRedeem"`              // 订单是否支持提前赎回
+	PurchasedTime            string                   `json:"purchasedTime"`            // 申购时间
+	EstSettlementTime        string                   `json:"estSettlementTime"`        // 预估赎回到账时间
+	CancelRedemptionDeadline string                   `json:"cancelRedemptionDeadline"` // 撤销赎回申请截止时间
+	Tag                      string                   `json:"tag"`                      // 订单标签
+	InvestData               []StakingDefiInvestData  `json:"investData"`               // 投资信息
+	EarningData              []StakingDefiEarningData `json:"earningData"`              // 收益信息
+}
+
+// StakingDefiActiveOrdersResponse 查看链上赚币活跃订单响应
+type StakingDefiActiveOrdersResponse struct {
+	Code string                       `json:"code"`
+	Msg  string                       `json:"msg"`
+	Data []StakingDefiActiveOrderData `json:"data"`
+}
+
+// StakingAmtRequest ETH/SOL 质押申购/赎回请求
+type StakingAmtRequest struct {
+	Amt string `json:"amt"` // 申购或赎回数量
+}
+
+// StakingAmtResponse ETH/SOL 质押申购/赎回响应
+type StakingAmtResponse struct {
+	Code string     `json:"code"`
+	Msg  string     `json:"msg"`
+	Data []struct{} `json:"data"`
+}
+
+// StakingBalanceData ETH/SOL 质押余额数据
+type StakingBalanceData struct {
+	Ccy                   string `json:"ccy"`                   // 币种，如 BETH、OKSOL
+	Amt                   string `json:"amt"`                   // 持有数量
+	LatestInterestAccrual string `json:"latestInterestAccrual"` // 最近一次发放的收益
+	TotalInterestAccrual  string `json:"totalInterestAccrual"`  // 累计发放的收益
+	Ts                    string `json:"ts"`                    // 查询时间，Unix 毫秒时间戳
+}
+
+// StakingBalanceResponse ETH/SOL 质押余额响应
+type StakingBalanceResponse struct {
+	Code string               `json:"code"`
+	Msg  string               `json:"msg"`
+	Data []StakingBalanceData `json:"data"`
+}
+
+// SubAccountData 子账户信息
+type SubAccountData struct {
+	Type        string   `json:"type"`        // 子账户类型 1：普通子账户 2：资管子账户 5：托管交易子账户
+	Enable      bool     `json:"enable"`      // 子账户状态 true：正常 false：冻结
+	SubAcct     string   `json:"subAcct"`     // 子账户名称
+	Uid         string   `json:"uid"`         // 子账户UID
+	Label       string   `json:"label"`       // 子账户备注
+	Mobile      string   `json:"mobile"`      // 子账户绑定手机号
+	GAuth       bool     `json:"gAuth"`       // 是否开启谷歌验证
+	FrozenFunc  []string `json:"frozenFunc"`  // 被冻结的功能
+	CanTransOut bool     `json:"canTransOut"` // 是否可以主动转出
+	Ts          string   `json:"ts"`          // 子账户创建时间，Unix 毫秒时间戳
+}
+
+// SubAccountListResponse 查看子账户列表响应
+type SubAccountListResponse struct {
+	Code string           `json:"code"`
+	Msg  string           `json:"msg"`
+	Data []SubAccountData `json:"data"`
+}
+
+// SubAccountTransferRequest 子账户间资金划转请求
+type SubAccountTransferRequest struct {
+	Ccy            string `json:"ccy"`                   // 币种
+	Amt            string `json:"amt"`                   // 划转数量
+	From           string `json:"from"`                  // 转出子账户的账户类型 6：资金账户 18：交易账户
+	To             string `json:"to"`                    // 转入子账户的账户类型 6：资金账户 18：交易账户
+	FromSubAccount string `json:"fromSubAccount"`        // 转出子账户名称
+	ToSubAccount   string `json:"toSubAccount"`          // 转入子账户名称
+	LoanTrans      bool   `json:"loanTrans,omitempty"`   // 是否支持跨币种保证金模式或组合保证金模式下的借币转入/转出
+	OmitPosRisk    string `json:"omitPosRisk,omitempty"` // 是否忽略仓位风险
+}
+
+// SubAccountTransferResponse 子账户间资金划转响应
+type SubAccountTransferResponse struct {
+	Code string `json:"code"`
+	Msg  string `json:"msg"`
+	Data []struct {
+		TransId string `json:"transId"` // 划转ID
+	} `json:"data"`
+}
+
+// SubAccountApiKeyData 子账户 API Key 信息
+type SubAccountApiKeyData struct {
+	Label  string `json:"label"`  // API Key 备注
+	ApiKey string `json:"apiKey"` // API 公钥
+	Perm   string `json:"perm"`   // API Key 权限 read_only/trade/withdraw
+	Ip     string `json:"ip"`     // API Key 绑定的 IP 地址
+	Ts     string `json:"ts"`     // 创建时间，Unix 毫秒时间戳
+}
+
+// SubAccountApiKeyResponse 查询子账户 API Key 响应
+type SubAccountApiKeyResponse struct {
+	Code string                 `json:"code"`
+	Msg  string                 `json:"msg"`
+	Data []SubAccountApiKeyData `json:"data"`
+}
+
+// AssetCurrencyData 币种列表数据
+type AssetCurrencyData struct {
+	Ccy                  string `json:"ccy"`                  // 币种名称，如 BTC
+	Name                 string `json:"name"`                 // 币种中文名称
+	Chain                string `json:"chain"`                // 币种链信息，如 USDT-TRC20
+	CanDep               bool   `json:"canDep"`               // 当前是否可充值
+	CanWd                bool   `json:"canWd"`                // 当前是否可提币
+	CanInternal          bool   `json:"canInternal"`          // 当前是否可内部转账
+	MinDep               string `json:"minDep"`               // 最小充值数量
+	MinWd                string `json:"minWd"`                // 最小链上提币数量
+	MaxWd                string `json:"maxWd"`                // 最大单笔提币数量
+	WdTickSz             string `json:"wdTickSz"`             // 提币精度，小数点后的位数
+	WdQuota              string `json:"wdQuota"`              // 过去24小时内提币额度，单位 USD
+	UsedWdQuota          string `json:"usedWdQuota"`          // 过去24小时内已用提币额度，单位 USD
+	Fee                  string `json:"fee"`                  // 链上提币手续费
+	MinFee               string `json:"minFee"`               // 最小提币手续费
+	MaxFee               string `json:"maxFee"`               // 最大提币手续费
+	MainNet              bool   `json:"mainNet"`              // 是否为主网
+	NeedTag              bool   `json:"needTag"`              // 提币是否需要标签
+	MinDepArrivalConfirm string `json:"minDepArrivalConfirm"` // 充值到账最小确认数
+	MinWdUnlockConfirm   string `json:"minWdUnlockConfirm"`   // 提币解锁最小确认数
+}
+
+// AssetCurrenciesResponse 获取币种列表响应
+type AssetCurrenciesResponse struct {
+	Code string              `json:"code"`
+	Msg  string              `json:"msg"`
+	Data []AssetCurrencyData `json:"data"`
+}
+
+// DepositAddressData 充值地址数据
+type DepositAddressData struct {
+	Addr     string `json:"addr"`     // 充值地址
+	Tag      string `json:"tag"`      // 部分币种充值需要标签
+	Memo     string `json:"memo"`     // 部分币种充值需要 memo
+	PmtId    string `json:"pmtId"`    // 部分币种充值需要 payment_id
+	Ccy      string `json:"ccy"`      // 币种
+	Chain    string `json:"chain"`    // 币种链信息
+	To       string `json:"to"`       // 转入账户 6：资金账户 18：交易账户
+	Selected bool   `json:"selected"` // 该地址是否为页面选中的地址
+	CtAddr   string `json:"ctAddr"`   // 合约地址后6位
+}
+
+// DepositAddressResponse 获取充值地址响应
+type DepositAddressResponse struct {
+	Code string               `json:"code"`
+	Msg  string               `json:"msg"`
+	Data []DepositAddressData `json:"data"`
+}
+
+// DepositHistoryData 充值记录数据
+type DepositHistoryData struct {
+	Ccy                 string `json:"ccy"`                 // 币种
+	Chain               string `json:"chain"`               // 币种链信息
+	Amt                 string `json:"amt"`                 // 充值数量
+	From                string `json:"from"`                // 充值账户，内部转账时为发起方账户
+	To                  string `json:"to"`                  // 到账地址
+	TxId                string `json:"txId"`                // 区块转账哈希记录，内部转账时为空
+	Ts                  string `json:"ts"`                  // 充值记录创建时间，Unix 毫秒时间戳
+	State               string `json:"state"`               // 充值状态 0：等待确认 1：确认到账 2：充值成功 8：因该币种暂停充值而未到账 12：账户或充值被冻结 13：子账户充值拦截
+	DepId               string `json:"depId"`               // 充值记录ID
+	ActualDepBlkConfirm string `json:"actualDepBlkConfirm"` // 最新的充币网络确认数
+	FromWdId            string `json:"fromWdId"`            // 内部转账发起者提币申请ID
+}
+
+// DepositHistoryResponse 获取充值记录响应
+type DepositHistoryResponse struct {
+	Code string               `json:"code"`
+	Msg  string               `json:"msg"`
+	Data []DepositHistoryData `json:"data"`
+}
+
+// WithdrawalRequest 提币请求
+type WithdrawalRequest struct {
+	Ccy      string `json:"ccy"`                // 币种，如 USDT
+	Amt      string `json:"amt"`                // 提币数量，不包含手续费
+	Dest     string `json:"dest"`               // 提币方式 3：内部转账 4：链上提币
+	ToAddr   string `json:"toAddr"`             // 提币地址，内部转账时为手机号、邮箱或账户名
+	Chain    string `json:"chain,omitempty"`    // 币种链信息，如 USDT-TRC20
+	ClientId string `json:"clientId,omitempty"` // 客户自定义ID
+}
+
+// WithdrawalData 提币响应数据
+type WithdrawalData struct {
+	Ccy      string `json:"ccy"`      // 币种
+	Chain    string `json:"chain"`    // 币种链信息
+	Amt      string `json:"amt"`      // 提币数量
+	WdId     string `json:"wdId"`     // 提币申请ID
+	ClientId string `json:"clientId"` // 客户自定义ID
+}
+
+// WithdrawalResponse 提币响应
+type WithdrawalResponse struct {
+	Code string           `json:"code"`
+	Msg  string           `json:"msg"`
+	Data []WithdrawalData `json:"data"`
+}
+
+// WithdrawalHistoryData 提币记录数据
+type WithdrawalHistoryData struct {
+	Ccy      string `json:"ccy"`      // 币种
+	Chain    string `json:"chain"`    // 币种链信息
+	Amt      string `json:"amt"`      // 提币数量
+	Ts       string `json:"ts"`       // 提币申请时间，Unix 毫秒时间戳
+	From     string `json:"from"`     // 提币账户
+	To       string `json:"to"`       // 收币地址
+	Tag      string `json:"tag"`      // 部分币种提币需要标签
+	PmtId    string `json:"pmtId"`    // 部分币种提币需要 payment_id
+	Memo     string `json:"memo"`     // 部分币种提币需要 memo
+	TxId     string `json:"txId"`     // 提币哈希记录，内部转账时为空
+	Fee      string `json:"fee"`      // 提币手续费数量
+	FeeCcy   string `json:"feeCcy"`   // 提币手续费币种
+	State    string `json:"state"`    // 提币状态 -3：撤销中 -2：已撤销 -1：失败 0：等待提币 1：提币中 2：提币成功 等
+	WdId     string `json:"wdId"`     // 提币申请ID
+	ClientId string `json:"clientId"` // 客户自定义ID
+}
+
+// WithdrawalHistoryResponse 获取提币记录响应
+type WithdrawalHistoryResponse struct {
+	Code string                  `json:"code"`
+	Msg  string                  `json:"msg"`
+	Data []WithdrawalHistoryData `json:"data"`
+}
+
+// AssetValuationData 账户资产估值数据
+type AssetValuationData struct {
+	TotalBal string `json:"totalBal"` // 账户总资产估值
+	Ts       string `json:"ts"`       // 数据更新时间，Unix 毫秒时间戳
+	Details  struct {
+		Funding string `json:"funding"` // 资金账户
+		Trading string `json:"trading"` // 交易账户
+		Classic string `json:"classic"` // 经典账户（已废弃）
+		Earn    string `json:"earn"`    // 金融账户
+	} `json:"details"`
+}
+
+// AssetValuationResponse 获取账户资产估值响应
+type AssetValuationResponse struct {
+	Code string               `json:"code"`
+	Msg  string               `json:"msg"`
+	Data []AssetValuationData `json:"data"`
+}
+
+// ConvertCurrencyData 闪兑币种数据
+type ConvertCurrencyData struct {
+	Ccy string `json:"ccy"` // 币种名称，如 BTC
+	Min string `json:"min"` // 支持闪兑的最小值（已废弃）
+	Max string `json:"max"` // 支持闪兑的最大值（已废弃）
+}
+
+// ConvertCurrenciesResponse 获取闪兑币种列表响应
+type ConvertCurrenciesResponse struct {
+	Code string                `json:"code"`
+	Msg  string                `json:"msg"`
+	Data []ConvertCurrencyData `json:"data"`
+}
+
+// ConvertCurrencyPairData 闪兑币对数据
+type ConvertCurrencyPairData struct {
+	InstId      string `json:"instId"`      // 币对，如 BTC-USDT
+	BaseCcy     string `json:"baseCcy"`     // 交易货币
+	BaseCcyMax  string `json:"baseCcyMax"`  // 交易货币支持闪兑的最大值
+	BaseCcyMin  string `json:"baseCcyMin"`  // 交易货币支持闪兑的最小值
+	QuoteCcy    string `json:"quoteCcy"`    // 计价货币
+	QuoteCcyMax string `json:"quoteCcyMax"` // 计价货币支持闪兑的最大值
+	QuoteCcyMin string `json:"quoteCcyMin"` // 计价货币支持闪兑的最小值
+}
+
+// ConvertCurrencyPairResponse 获取闪兑币对信息响应
+type ConvertCurrencyPairResponse struct {
+	Code string                    `json:"code"`
+	Msg  string                    `json:"msg"`
+	Data []ConvertCurrencyPairData `json:"data"`
+}
+
+// ConvertEstimateQuoteRequest 闪兑预估询价请求
+type ConvertEstimateQuoteRequest struct {
+	BaseCcy  string `json:"baseCcy"`            // 交易货币，如 BTC-USDT 中的 BTC
+	QuoteCcy string `json:"quoteCcy"`           // 计价货币，如 BTC-USDT 中的 USDT
+	Side     string `json:"side"`               // 交易方向 buy：买 sell：卖，描述的是对于 baseCcy 的交易方向
+	RfqSz    string `json:"rfqSz"`              // 询价数量
+	RfqSzCcy string `json:"rfqSzCcy"`           // 询价币种
+	ClQReqId string `json:"clQReqId,omitempty"` // 客户端自定义的询价ID
+}
+
+// ConvertEstimateQuoteData 闪兑预估询价数据
+type ConvertEstimateQuoteData struct {
+	QuoteId   string `json:"quoteId"`   // 报价ID
+	BaseCcy   string `json:"baseCcy"`   // 交易货币
+	QuoteCcy  string `json:"quoteCcy"`  // 计价货币
+	Side      string `json:"side"`      // 交易方向
+	RfqSz     string `json:"rfqSz"`     // 询价数量
+	RfqSzCcy  string `json:"rfqSzCcy"`  // 询价币种
+	CnvtPx    string `json:"cnvtPx"`    // 闪兑价格，单位为计价币
+	BaseSz    string `json:"baseSz"`    // 闪兑交易币数量
+	QuoteSz   string `json:"quoteSz"`   // 闪兑计价币数量
+	TtlMs     string `json:"ttlMs"`     // 报价有效期，单位为毫秒
+	QuoteTime string `json:"quoteTime"` // 生成报价时间，Unix 毫秒时间戳
+	ClQReqId  string `json:"clQReqId"`  // 客户端自定义的询价ID
+}
+
+// ConvertEstimateQuoteResponse 闪兑预估询价响应
+type ConvertEstimateQuoteResponse struct {
+	Code string                     `json:"code"`
+	Msg  string                     `json:"msg"`
+	Data []ConvertEstimateQuoteData `json:"data"`
+}
+
+// ConvertTradeRequest 闪兑交易请求
+type ConvertTradeRequest struct {
+	QuoteId  string `json:"quoteId"`            // 报价ID
+	BaseCcy  string `json:"baseCcy"`            // 交易货币
+	QuoteCcy string `json:"quoteCcy"`           // 计价货币
+	Side     string `json:"side"`               // 交易方向 buy：买 sell：卖
+	Sz       string `json:"sz"`                 // 用户报价数量，不得大于询价数量
+	SzCcy    string `json:"szCcy"`              // 用户报价币种
+	ClTReqId string `json:"clTReqId,omitempty"` // 用户自定义的订单标识
+}
+
+// ConvertTradeData 闪兑交易数据
+type ConvertTradeData struct {
+	TradeId     string `json:"tradeId"`     // 成交ID
+	QuoteId     string `json:"quoteId"`     // 报价ID
+	ClTReqId    string `json:"clTReqId"`    // 用户自定义的订单标识
+	State       string `json:"state"`       // 闪兑交易状态 fullyFilled：交易成功 rejected：交易失败
+	InstId      string `json:"instId"`      // 币对，如 BTC-USDT
+	BaseCcy     string `json:"baseCcy"`     // 交易货币
+	QuoteCcy    string `json:"quoteCcy"`    // 计价货币
+	Side        string `json:"side"`        // 交易方向
+	FillPx      string `json:"fillPx"`      // 成交价格，单位为计价币
+	FillBaseSz  string `json:"fillBaseSz"`  // 交易货币的成交数量
+	FillQuoteSz string `json:"fillQuoteSz"` // 计价货币的成交数量
+	Ts          string `json:"ts"`          // 闪兑交易时间，Unix 毫秒时间戳
+}
+
+// ConvertTradeResponse 闪兑交易/闪兑交易历史响应
+type ConvertTradeResponse struct {
+	Code string             `json:"code"`
+	Msg  string             `json:"msg"`
+	Data []ConvertTradeData `json:"data"`
+}
+
+// MaxSizeData 最大可下单数量数据
+type MaxSizeData struct {
+	InstId  string `json:"instId"`  // 产品ID
+	Ccy     string `json:"ccy"`     // 保证金币种
+	MaxBuy  string `json:"maxBuy"`  // 最大可买数量
+	MaxSell string `json:"maxSell"` // 最大可卖数量
+}
+
+// MaxSizeResponse 获取最大可下单数量响应
+type MaxSizeResponse struct {
+	Code string        `json:"code"`
+	Msg  string        `json:"msg"`
+	Data []MaxSizeData `json:"data"`
+}
+
+// MaxAvailSizeData 最大可用数量数据
+type MaxAvailSizeData struct {
+	InstId    string `json:"instId"`    // 产品ID
+	AvailBuy  string `json:"availBuy"`  // 最大买入可用数量
+	AvailSell string `json:"availSell"` // 最大卖出可用数量
+}
+
+// MaxAvailSizeResponse 获取最大可用数量响应
+type MaxAvailSizeResponse struct {
+	Code string             `json:"code"`
+	Msg  string             `json:"msg"`
+	Data []MaxAvailSizeData `json:"data"`
+}
+
+// MaxLoanData 最大可借数据
+type MaxLoanData struct {
+	InstId  string `json:"instId"`  // 产品ID
+	MgnMode string `json:"mgnMode"` // 保证金模式
+	MgnCcy  string `json:"mgnCcy"`  // 保证金币种
+	MaxLoan string `json:"maxLoan"` // 最大可借
+	Ccy     string `json:"ccy"`     // 币种
+	Side    string `json:"side"`    // 订单方向
+}
+
+// MaxLoanResponse 获取交易产品最大可借响应
+type MaxLoanResponse struct {
+	Code string        `json:"code"`
+	Msg  string        `json:"msg"`
+	Data []MaxLoanData `json:"data"`
+}
+
+// RiskStateResponse 查看账户特定风险状态响应，仅适用于组合保证金账户
+type RiskStateResponse struct {
+	Code string `json:"code"`
+	Msg  string `json:"msg"`
+	Data []struct {
+		AtRisk    bool     `json:"atRisk"`    // 自动借币模式下的账户风险状态
+		AtRiskIdx []string `json:"atRiskIdx"` // 衍生品的风险单元列表
+		AtRiskMgn []string `json:"atRiskMgn"` // 杠杆的风险单元列表
+		Ts        string   `json:"ts"`        // 接口数据返回时间
+	} `json:"data"`
+}
+
+// AmendAttachAlgoOrd 修改订单附带的止盈止损，新价格为 0 时表示删除对应的止盈或止损
+type AmendAttachAlgoOrd struct {
+	AttachAlgoId         string `json:"attachAlgoId,omitempty"`         // 附带止盈止损的订单ID，与 attachAlgoClOrdId 二选一
+	AttachAlgoClOrdId    string `json:"attachAlgoClOrdId,omitempty"`    // 客户自定义的策略订单ID
+	NewTpTriggerPx       string `json:"newTpTriggerPx,omitempty"`       // 止盈触发价
+	NewTpOrdPx           string `json:"newTpOrdPx,omitempty"`           // 止盈委托价，-1 为市价
+	NewSlTriggerPx       string `json:"newSlTriggerPx,omitempty"`       // 止损触发价
+	NewSlOrdPx           string `json:"newSlOrdPx,omitempty"`           // 止损委托价，-1 为市价
+	NewTpTriggerPxType   string `json:"newTpTriggerPxType,omitempty"`   // 止盈触发价类型
+	NewSlTriggerPxType   string `json:"newSlTriggerPxType,omitempty"`   // 止损触发价类型
+	Sz                   string `json:"sz,omitempty"`                   // 新的数量，仅适用于分批止盈
+	AmendPxOnTriggerType string `json:"amendPxOnTriggerType,omitempty"` // 是否启用开仓价止损 0：不开启 1：开启
+}
+
+// AmendOrderRequest 修改订单请求
+type AmendOrderRequest struct {
+	InstId         string               `json:"instId"`                   // 产品ID
+	OrdId          string               `json:"ordId,omitempty"`          // 订单ID，与 clOrdId 二选一
+	ClOrdId        string               `json:"clOrdId,omitempty"`        // 客户自定义订单ID
+	ReqId          string               `json:"reqId,omitempty"`          // 用户自定义修改事件ID
+	CxlOnFail      bool                 `json:"cxlOnFail,omitempty"`      // 修改失败时是否自动撤单
+	NewSz          string               `json:"newSz,omitempty"`          // 修改的新数量
+	NewPx          string               `json:"newPx,omitempty"`          // 修改后的新价格
+	AttachAlgoOrds []AmendAttachAlgoOrd `json:"attachAlgoOrds,omitempty"` // 修改附带的止盈止损
+}
+
+// AmendOrderResponse 修改订单响应
+type AmendOrderResponse struct {
+	Code string `json:"code"`
+	Msg  string `json:"msg"`
+	Data []struct {
+		OrdId   string `json:"ordId"`   // 订单ID
+		ClOrdId string `json:"clOrdId"` // 客户自定义订单ID
+		ReqId   string `json:"reqId"`   // 用户自定义修改事件ID
+		SCode   string `json:"sCode"`   // 事件执行结果的code，0代表成功
+		SMsg    string `json:"sMsg"`    // 事件执行失败时的msg
+	} `json:"data"`
+}
+
+// AmendAlgoOrderRequest 修改策略委托订单请求，仅支持止盈止损和计划委托
+type AmendAlgoOrderRequest struct {
+	InstId             string `json:"instId"`                       // 产品ID
+	AlgoId             string `json:"algoId,omitempty"`             // 策略委托单ID，与 algoClOrdId 二选一
+	AlgoClOrdId        string `json:"algoClOrdId,omitempty"`        // 客户自定义策略订单ID
+	CxlOnFail          bool   `json:"cxlOnFail,omitempty"`          // 修改失败时是否自动撤单
+	ReqId              string `json:"reqId,omitempty"`              // 用户自定义修改事件ID
+	NewSz              string `json:"newSz,omitempty"`              // 修改的新数量
+	NewTpTriggerPx     string `json:"newTpTriggerPx,omitempty"`     // 止盈触发价，0 表示删除止盈
+	NewTpOrdPx         string `json:"newTpOrdPx,omitempty"`         // 止盈委托价，-1 为市价
+	NewSlTriggerPx     string `json:"newSlTriggerPx,omitempty"`     // 止损触发价，0 表示删除止损
+	NewSlOrdPx         string `json:"newSlOrdPx,omitempty"`         // 止损委托价，-1 为市价
+	NewTpTriggerPxType string `json:"newTpTriggerPxType,omitempty"` // 止盈触发价类型
+	NewSlTriggerPxType string `json:"newSlTriggerPxType,omitempty"` // 止损触发价类型
+}
+
+// AmendAlgoOrderResponse 修改策略委托订单响应
+type AmendAlgoOrderResponse struct {
+	Code string `json:"code"`
+	Msg  string `json:"msg"`
+	Data []struct {
+		AlgoId      string `json:"algoId"`      // 策略委托单ID
+		AlgoClOrdId string `json:"algoClOrdId"` // 客户自定义策略订单ID
+		ReqId       string `json:"reqId"`       // 用户自定义修改事件ID
+		SCode       string `json:"sCode"`       // 事件执行结果的code，0代表成功
+		SMsg        string `json:"sMsg"`        // 事件执行失败时的msg
+	} `json:"data"`
+}
+
+// AlgoOrderData 策略委托单详情，包含未完成列表中的字段和触发相关字段
+type AlgoOrderData struct {
+	AlgoOrdersPendingData
+	OrdPx         string   `json:"ordPx"`         // 计划委托的委托价格
+	TriggerPx     string   `json:"triggerPx"`     // 计划委托的触发价格
+	TriggerPxType string   `json:"triggerPxType"` // 计划委托的触发价格类型
+	TriggerTime   string   `json:"triggerTime"`   // 策略委托触发时间，Unix 毫秒时间戳
+	OrdId         string   `json:"ordId"`         // 触发后生成的订单ID（已废弃，使用 ordIdList）
+	OrdIdList     []string `json:"ordIdList"`     // 触发后生成的订单ID列表
+	CloseFraction string   `json:"closeFraction"` // 策略委托平仓百分比，1 代表全部平仓
+	ReduceOnly    string   `json:"reduceOnly"`    // 是否只减仓 true 或 false
+	CallbackRatio string   `json:"callbackRatio"` // 移动止盈止损的回调幅度比例
+	MoveTriggerPx string   `json:"moveTriggerPx"` // 移动止盈止损的触发价格
+	FailCode      string   `json:"failCode"`      // 策略委托触发失败时的错误码
+}
+
+// AlgoOrderInfoResponse 策略委托单详情/历史响应
+type AlgoOrderInfoResponse struct {
+	Code string          `json:"code"`
+	Msg  string          `json:"msg"`
+	Data []AlgoOrderData `json:"data"`
+}
+
+// MarketTradesResponse 获取交易产品公共成交数据响应
+type MarketTradesResponse struct {
+	Code string `json:"code"`
+	Msg  string `json:"msg"`
+	Data []struct {
+		InstId  string `json:"instId"`  // 产品ID
+		TradeId string `json:"tradeId"` // 成交ID
+		Px      string `json:"px"`      // 成交价格
+		Sz      string `json:"sz"`      // 成交数量
+		Side    string `json:"side"`    // 吃单方向 buy/sell
+		Ts      string `json:"ts"`      // 成交时间，Unix 毫秒时间戳
+	} `json:"data"`
+}
+
+// TradingBotOrderResponse 策略交易（网格、定投）下单/修改/停止响应
+type TradingBotOrderResponse struct {
+	Code string `json:"code"`
+	Msg  string `json:"msg"`
+	Data []struct {
+		AlgoId      string `json:"algoId"`      // 策略订单ID
+		AlgoClOrdId string `json:"algoClOrdId"` // 客户自定义策略订单ID
+		SCode       string `json:"sCode"`       // 事件执行结果的code，0代表成功
+		SMsg        string `json:"sMsg"`        // 事件执行失败时的msg
+		Tag         string `json:"tag"`         // 订单标签
+	} `json:"data"`
+}
+
+// GridOrderRequest 网格策略委托下单请求
+type GridOrderRequest struct {
+	InstId      string `json:"instId"`                // 产品ID
+	AlgoOrdType string `json:"algoOrdType"`           // 策略订单类型 grid：现货网格 contract_grid：合约网格
+	MaxPx       string `json:"maxPx"`                 // 区间最高价格
+	MinPx       string `json:"minPx"`                 // 区间最低价格
+	GridNum     string `json:"gridNum"`               // 网格数量
+	RunType     string `json:"runType,omitempty"`     // 网格类型 1：等差 2：等比，默认等差
+	QuoteSz     string `json:"quoteSz,omitempty"`     // 现货网格计价货币投入数量，与 baseSz 至少提供一个
+	BaseSz      string `json:"baseSz,omitempty"`      // 现货网格交易货币投入数量
+	Sz          string `json:"sz,omitempty"`          // 合约网格投入保证金
+	Direction   string `json:"direction,omitempty"`   // 合约网格类型 long：做多 short：做空 neutral：中性
+	Lever       string `json:"lever,omitempty"`       // 合约网格杠杆倍数
+	BasePos     bool   `json:"basePos,omitempty"`     // 合约网格是否开底仓
+	TpTriggerPx string `json:"tpTriggerPx,omitempty"` // 止盈触发价
+	SlTriggerPx string `json:"slTriggerPx,omitempty"` // 止损触发价
+	TpRatio     string `json:"tpRatio,omitempty"`     // 合约网格止盈比率，0.1 代表 10%
+	SlRatio     string `json:"slRatio,omitempty"`     // 合约网格止损比率，0.1 代表 10%
+	AlgoClOrdId string `json:"algoClOrdId,omitempty"` // 客户自定义策略订单ID
+	Tag         string `json:"tag,omitempty"`         // 订单标签
+}
+
+// GridAmendRequest 修改网格策略订单请求，止盈止损传空字符串表示不修改
+type GridAmendRequest struct {
+	AlgoId      string `json:"algoId"`                // 策略订单ID
+	InstId      string `json:"instId"`                // 产品ID
+	SlTriggerPx string `json:"slTriggerPx,omitempty"` // 新的止损触发价
+	TpTriggerPx string `json:"tpTriggerPx,omitempty"` // 新的止盈触发价
+	TpRatio     string `json:"tpRatio,omitempty"`     // 合约网格新的止盈比率
+	SlRatio     string `json:"slRatio,omitempty"`     // 合约网格新的止损比率
+}
+
+// GridStopRequest 停止网格策略订单请求
+type GridStopRequest struct {
+	AlgoId      string `json:"algoId"`      // 策略订单ID
+	InstId      string `json:"instId"`      // 产品ID
+	AlgoOrdType string `json:"algoOrdType"` // 策略订单类型 grid/contract_grid
+	StopType    string `json:"stopType"`    // 现货网格 1：卖出交易币 2：不卖出交易币；合约网格 1：市价全平 2：停止不平仓
+}
+
+// GridAlgoOrderData 网格策略订单信息
+type GridAlgoOrderData struct {
+	AlgoId         string `json:"algoId"`         // 策略订单ID
+	AlgoClOrdId    string `json:"algoClOrdId"`    // 客户自定义策略订单ID
+	InstType       string `json:"instType"`       // 产品类型
+	InstId         string `json:"instId"`         // 产品ID
+	CTime          string `json:"cTime"`          // 策略创建时间，Unix 毫秒时间戳
+	UTime          string `json:"uTime"`          // 策略更新时间，Unix 毫秒时间戳
+	AlgoOrdType    string `json:"algoOrdType"`    // 策略订单类型 grid/contract_grid
+	State          string `json:"state"`          // 订单状态 starting/running/stopping/pending_signal/no_close_position/stopped
+	MaxPx          string `json:"maxPx"`          // 区间最高价格
+	MinPx          string `json:"minPx"`          // 区间最低价格
+	GridNum        string `json:"gridNum"`        // 网格数量
+	RunType        string `json:"runType"`        // 网格类型 1：等差 2：等比
+	TpTriggerPx    string `json:"tpTriggerPx"`    // 止盈触发价
+	SlTriggerPx    string `json:"slTriggerPx"`    // 止损触发价
+	TpRatio        string `json:"tpRatio"`        // 止盈比率
+	SlRatio        string `json:"slRatio"`        // 止损比率
+	ArbitrageNum   string `json:"arbitrageNum"`   // 网格套利次数
+	TotalPnl       string `json:"totalPnl"`       // 总收益
+	PnlRatio       string `json:"pnlRatio"`       // 收益率
+	Investment     string `json:"investment"`     // 累计投入金额
+	GridProfit     string `json:"gridProfit"`     // 网格利润
+	FloatProfit    string `json:"floatProfit"`    // 浮动盈亏
+	TotalFee       string `json:"totalFee"`       // 累计手续费
+	CancelType     string `json:"cancelType"`     // 网格策略停止原因 0：无 1：手动停止 2：止盈停止 3：止损停止 4：风控停止 5：交割停止
+	StopType       string `json:"stopType"`       // 网格策略停止类型
+	QuoteSz        string `json:"quoteSz"`        // 现货网格计价货币投入数量
+	BaseSz         string `json:"baseSz"`         // 现货网格交易货币投入数量
+	CurQuoteSz     string `json:"curQuoteSz"`     // 现货网格当前持有的计价货币资产
+	CurBaseSz      string `json:"curBaseSz"`      // 现货网格当前持有的交易货币资产
+	Direction      string `json:"direction"`      // 合约网格类型 long/short/neutral
+	BasePos        bool   `json:"basePos"`        // 合约网格是否开底仓
+	Sz             string `json:"sz"`             // 合约网格投入保证金
+	Lever          string `json:"lever"`          // 杠杆倍数
+	ActualLever    string `json:"actualLever"`    // 实际杠杆倍数
+	LiqPx          string `json:"liqPx"`          // 预估强平价格
+	Eq             string `json:"eq"`             // 策略账户总权益
+	RunPx          string `json:"runPx"`          // 启动时的价格
+	AnnualizedRate string `json:"annualizedRate"` // 网格年化收益率
+	Tag            string `json:"tag"`            // 订单标签
+}
+
+// GridAlgoOrdersResponse 网格策略订单列表/详情响应
+type GridAlgoOrdersResponse struct {
+	Code string              `json:"code"`
+	Msg  string              `json:"msg"`
+	Data []GridAlgoOrderData `json:"data"`
+}
+
+// GridSubOrdersResponse 网格策略子订单响应
+type GridSubOrdersResponse struct {
+	Code string `json:"code"`
+	Msg  string `json:"msg"`
+	Data []struct {
+		AlgoId      string `json:"algoId"`      // 策略订单ID
+		AlgoClOrdId string `json:"algoClOrdId"` // 客户自定义策略订单ID
+		InstType    string `json:"instType"`    // 产品类型
+		InstId      string `json:"instId"`      // 产品ID
+		AlgoOrdType string `json:"algoOrdType"` // 策略订单类型
+		GroupId     string `json:"groupId"`     // 组ID，同一组的买卖单为一次网格套利
+		OrdId       string `json:"ordId"`       // 子订单ID
+		CTime       string `json:"cTime"`       // 子订单创建时间，Unix 毫秒时间戳
+		UTime       string `json:"uTime"`       // 子订单更新时间，Unix 毫秒时间戳
+		TdMode      string `json:"tdMode"`      // 交易模式
+		OrdType     string `json:"ordType"`     // 订单类型
+		Sz          string `json:"sz"`          // 委托数量
+		State       string `json:"state"`       // 订单状态 live/partially_filled/filled/canceled
+		Side        string `json:"side"`        // 订单方向
+		PosSide     string `json:"posSide"`     // 持仓方向
+		Px          string `json:"px"`          // 委托价格
+		AvgPx       string `json:"avgPx"`       // 成交均价
+		AccFillSz   string `json:"accFillSz"`   // 累计成交数量
+		Fee         string `json:"fee"`         // 手续费，负数表示扣除
+		FeeCcy      string `json:"feeCcy"`      // 手续费币种
+		Pnl         string `json:"pnl"`         // 收益
+		CtVal       string `json:"ctVal"`       // 合约面值
+		Lever       string `json:"lever"`       // 杠杆倍数
+		Tag         string `json:"tag"`         // 订单标签
+	} `json:"data"`
+}
+
+// GridPositionsResponse 合约网格持仓响应
+type GridPositionsResponse struct {
+	Code string `json:"code"`
+	Msg  string `json:"msg"`
+	Data []struct {
+		AlgoId      string `json:"algoId"`      // 策略订单ID
+		AlgoClOrdId string `json:"algoClOrdId"` // 客户自定义策略订单ID
+		InstType    string `json:"instType"`    // 产品类型
+		InstId      string `json:"instId"`      // 产品ID
+		CTime       string `json:"cTime"`       // 持仓创建时间，Unix 毫秒时间戳
+		UTime       string `json:"uTime"`       // 持仓更新时间，Unix 毫秒时间戳
+		AvgPx       string `json:"avgPx"`       // 开仓均价
+		Ccy         string `json:"ccy"`         // 保证金币种
+		Lever       string `json:"lever"`       // 杠杆倍数
+		LiqPx       string `json:"liqPx"`       // 预估强平价
+		PosSide     string `json:"posSide"`     // 持仓方向
+		Pos         string `json:"pos"`         // 持仓数量
+		MgnMode     string `json:"mgnMode"`     // 保证金模式
+		MgnRatio    string `json:"mgnRatio"`    // 保证金率
+		Imr         string `json:"imr"`         // 初始保证金
+		Mmr         string `json:"mmr"`         // 维持保证金
+		Upl         string `json:"upl"`         // 未实现收益
+		UplRatio    string `json:"uplRatio"`    // 未实现收益率
+		Last        string `json:"last"`        // 最新成交价
+		MarkPx      string `json:"markPx"`      // 标记价格
+		NotionalUsd string `json:"notionalUsd"` // 以美金价值为单位的持仓数量
+		Adl         string `json:"adl"`         // 自动减仓信号区
+	} `json:"data"`
+}
+
+// RecurringItem 定投币种及占比
+type RecurringItem struct {
+	Ccy   string `json:"ccy"`   // 定投币种，如 BTC
+	Ratio string `json:"ratio"` // 定投比例，如 0.2 代表 20%，各币种之和为 1
+}
+
+// RecurringOrderRequest 定投策略委托下单请求
+type RecurringOrderRequest struct {
+	StgyName      string          `json:"stgyName"`                // 策略自定义名称
+	RecurringList []RecurringItem `json:"recurringList"`           // 定投币种及占比
+	Period        string          `json:"period"`                  // 周期类型 monthly/weekly/daily/hourly
+	RecurringDay  string          `json:"recurringDay,omitempty"`  // 投资日，月定投为 1-28，周定投为 1-7
+	RecurringHour string          `json:"recurringHour,omitempty"` // 小时定投的间隔小时数 1/4/8/12
+	RecurringTime string          `json:"recurringTime"`           // 投资时间，0-23
+	TimeZone      string          `json:"timeZone"`                // 时区（UTC），如 8 代表 UTC+8
+	Amt           string          `json:"amt"`                     // 每期投入数量
+	InvestmentCcy string          `json:"investmentCcy"`           // 投入币种，如 USDT
+	TdMode        string          `json:"tdMode"`                  // 交易模式 cross：跨币种保证金模式 cash：现货
+	AlgoClOrdId   string          `json:"algoClOrdId,omitempty"`   // 客户自定义策略订单ID
+	Tag           string          `json:"tag,omitempty"`           // 订单标签
+}
+
+// RecurringAmendRequest 修改定投策略订单请求
+type RecurringAmendRequest struct {
+	AlgoId   string `json:"algoId"`   // 策略订单ID
+	StgyName string `json:"stgyName"` // 新的策略自定义名称
+}
+
+// RecurringStopRequest 停止定投策略订单请求
+type RecurringStopRequest struct {
+	AlgoId string `json:"algoId"` // 策略订单ID
+}
+
+// RecurringOrderData 定投策略订单信息
+type RecurringOrderData struct {
+	AlgoId        string `json:"algoId"`      // 策略订单ID
+	AlgoClOrdId   string `json:"algoClOrdId"` // 客户自定义策略订单ID
+	InstType      string `json:"instType"`    // 产品类型
+	CTime         string `json:"cTime"`       // 策略创建时间，Unix 毫秒时间戳
+	UTime         string `json:"uTime"`       // 策略更新时间，Unix 毫秒时间戳
+	AlgoOrdType   string `json:"algoOrdType"` // 策略订单类型 recurring
+	State         string `json:"state"`       // 订单状态 running/stopping/stopped/pause
+	StgyName      string `json:"stgyName"`    // 策略自定义名称
+	RecurringList []struct {
+		Ccy      string `json:"ccy"`      // 定投币种
+		Ratio    string `json:"ratio"`    // 定投比例
+		TotalAmt string `json:"totalAmt"` // 累计买入数量
+		Profit   string `json:"profit"`   // 定投收益
+		AvgPx    string `json:"avgPx"`    // 定投均价
+		Px       string `json:"px"`       // 当前价格
+	} `json:"recurringList"` // 定投币种信息
+	Period        string `json:"period"`        // 周期类型
+	RecurringDay  string `json:"recurringDay"`  // 投资日
+	RecurringHour string `json:"recurringHour"` // 小时定投的间隔小时数
+	RecurringTime string `json:"recurringTime"` // 投资时间
+	TimeZone      string `json:"timeZone"`      // 时区
+	Amt           string `json:"amt"`           // 每期投入数量
+	InvestmentAmt string `json:"investmentAmt"` // 累计投入数量
+	InvestmentCcy string `json:"investmentCcy"` // 投入币种
+	TotalPnl      string `json:"totalPnl"`      // 总收益
+	TotalAnnRate  string `json:"totalAnnRate"`  // 总年化收益率
+	PnlRatio      string `json:"pnlRatio"`      // 收益率
+	MktCap        string `json:"mktCap"`        // 当前市值
+	Cycles        string `json:"cycles"`        // 已执行的期数
+	Tag           string `json:"tag"`           // 订单标签
+}
+
+// RecurringOrdersResponse 定投策略订单列表/详情响应
+type RecurringOrdersResponse struct {
+	Code string               `json:"code"`
+	Msg  string               `json:"msg"`
+	Data []RecurringOrderData `json:"data"`
+}
+
+// RecurringSubOrdersResponse 定投策略子订单响应
+type RecurringSubOrdersResponse struct {
+	Code string `json:"code"`
+	Msg  string `json:"msg"`
+	Data []struct {
+		AlgoId      string `json:"algoId"`      // 策略订单ID
+		AlgoClOrdId string `json:"algoClOrdId"` // 客户自定义策略订单ID
+		InstType    string `json:"instType"`    // 产品类型
+		InstId      string `json:"instId"`      // 产品ID
+		AlgoOrdType string `json:"algoOrdType"` // 策略订单类型
+		OrdId       string `json:"ordId"`       // 子订单ID
+		CTime       string `json:"cTime"`       // 子订单创建时间，Unix 毫秒时间戳
+		UTime       string `json:"uTime"`       // 子订单更新时间，Unix 毫秒时间戳
+		TdMode      string `json:"tdMode"`      // 交易模式
+		OrdType     string `json:"ordType"`     // 订单类型
+		Sz          string `json:"sz"`          // 委托数量
+		State       string `json:"state"`       // 订单状态
+		Side        string `json:"side"`        // 订单方向
+		Px          string `json:"px"`          // 委托价格
+		AvgPx       string `json:"avgPx"`       // 成交均价
+		AccFillSz   string `json:"accFillSz"`   // 累计成交数量
+		Fee         string `json:"fee"`         // 手续费，负数表示扣除
+		FeeCcy      string `json:"feeCcy"`      // 手续费币种
+		Tag         string `json:"tag"`         // 订单标签
+	} `json:"data"`
+}
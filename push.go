@@ -1,71 +1,287 @@
 package galatvtr
 
 import (
-	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"io"
+	"mime"
+	"net"
 	"net/http"
+	"net/smtp"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // sendBarkMessage 发送Bark消息
 func PushMsgBark(token, title, content, status string) error {
-	// Bark API URL格式: https://api.day.app/{token}/{title}/{content}
-	// barkURL := fmt.Sprintf("https://api.day.app/%s", req.Token)
-
 	sound := "anticipate"
 	if status == "success" {
 		sound = "alarm"
 	}
 
-	barkURL := fmt.Sprintf("https://api.day.app/%s/%s/%s?sound=%s",
-		token, url.QueryEscape(title), url.QueryEscape(content), sound)
-
-	_, err := http.Get(barkURL)
+	client := &BarkClient{DeviceKey: token}
+	err := client.Push(context.Background(), BarkPush{Title: title, Body: content, Sound: sound})
 	if err != nil {
 		// 如果bark推送失败，可以在这里记录日志，但不影响主要的错误响应
 		fmt.Printf("Bark推送失败: %v\n", err)
-		return fmt.Errorf("failed to marshal bark request: %v", err)
 	}
-
-	return nil
+	return err
 }
 
 // sendDingDingMessage 发送钉钉消息
 func PushMsgDingding(token, title, content, name string) error {
-	// 钉钉机器人webhook URL
-	url := fmt.Sprintf("https://oapi.dingtalk.com/robot/send?access_token=%s", token)
+	robot := &DingTalkRobot{Token: token}
+	return robot.SendText(context.Background(), fmt.Sprintf("【%s通知】%s：%s", name, title, content), nil)
+}
+
+// 默认推送服务地址
+const (
+	defaultTelegramApi = "https://api.telegram.org"
+	// Discord 单条消息的最大长度
+	discordMaxContent = 2000
+)
+
+// WeComNotifier 企业微信群机器人推送
+type WeComNotifier struct {
+	Key    string       `json:"key"` // 机器人 webhook 的 key
+	Client *http.Client `json:"-"`   // 为空时使用默认客户端
+}
 
-	type DingDingRequest struct {
-		MsgType string `json:"msgtype"`
-		Text    struct {
-			Content string `json:"content"`
-		} `json:"text"`
+func (n *WeComNotifier) Send(ctx context.Context, msg Message) error {
+	payload := map[string]interface{}{
+		"msgtype": "text",
+		"text":    map[string]string{"content": msg.Text()},
 	}
+	body, err := postJSON(ctx, n.Client, "https://qyapi.weixin.qq.com/cgi-bin/webhook/send?key="+url.QueryEscape(n.Key), payload, nil)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		ErrCode int    `json:"errcode"`
+		ErrMsg  string `json:"errmsg"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return err
+	}
+	if result.ErrCode != 0 {
+		return fmt.Errorf("企业微信推送失败: %d %s", result.ErrCode, result.ErrMsg)
+	}
+	return nil
+}
+
+// FeishuNotifier 飞书/Lark 群机器人推送
+type FeishuNotifier struct {
+	WebhookURL string       `json:"webhookUrl"`       // 机器人 webhook 地址，Lark 使用 open.larksuite.com 域名
+	Secret     string       `json:"secret,omitempty"` // 签名校验密钥，未开启签名校验时为空
+	Client     *http.Client `json:"-"`                // 为空时使用默认客户端
+}
 
-	dingReq := DingDingRequest{
-		MsgType: "text",
+func (n *FeishuNotifier) Send(ctx context.Context, msg Message) error {
+	payload := map[string]interface{}{
+		"msg_type": "text",
+		"content":  map[string]string{"text": msg.Text()},
 	}
-	dingReq.Text.Content = fmt.Sprintf("【%s通知】%s：%s", name, title, content)
+	if n.Secret != "" {
+		// 飞书签名以 timestamp + "\n" + secret 为密钥，对空字符串做 HmacSHA256
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		mac := hmac.New(sha256.New, []byte(timestamp+"\n"+n.Secret))
+		payload["timestamp"] = timestamp
+		payload["sign"] = base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	}
+	body, err := postJSON(ctx, n.Client, n.WebhookURL, payload, nil)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		Code int    `json:"code"`
+		Msg  string `json:"msg"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return err
+	}
+	if result.Code != 0 {
+		return fmt.Errorf("飞书推送失败: %d %s", result.Code, result.Msg)
+	}
+	return nil
+}
 
-	jsonData, err := json.Marshal(dingReq)
+// TelegramNotifier Telegram 机器人推送
+type TelegramNotifier struct {
+	ApiURL   string       `json:"apiUrl,omitempty"` // Bot API 地址，默认官方地址，可替换为反向代理
+	BotToken string       `json:"botToken"`         // 机器人 token
+	ChatId   string       `json:"chatId"`           // 接收消息的会话ID
+	Client   *http.Client `json:"-"`                // 为空时使用默认客户端
+}
+
+func (n *TelegramNotifier) Send(ctx context.Context, msg Message) error {
+	api := n.ApiURL
+	if api == "" {
+		api = defaultTelegramApi
+	}
+	payload := map[string]string{
+		"chat_id": n.ChatId,
+		"text":    msg.Text(),
+	}
+	body, err := postJSON(ctx, n.Client, strings.TrimSuffix(api, "/")+"/bot"+n.BotToken+"/sendMessage", payload, nil)
 	if err != nil {
-		return fmt.Errorf("failed to marshal dingding request: %v", err)
+		return err
+	}
+	var result struct {
+		Ok          bool   `json:"ok"`
+		Description string `json:"description"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return err
+	}
+	if !result.Ok {
+		return fmt.Errorf("Telegram 推送失败: %s", result.Description)
+	}
+	return nil
+}
+
+// SlackNotifier Slack Incoming Webhook 推送
+type SlackNotifier struct {
+	WebhookURL string       `json:"webhookUrl"` // Incoming Webhook 地址
+	Client     *http.Client `json:"-"`          // 为空时使用默认客户端
+}
+
+func (n *SlackNotifier) Send(ctx context.Context, msg Message) error {
+	_, err := postJSON(ctx, n.Client, n.WebhookURL, map[string]string{"text": msg.Text()}, nil)
+	return err
+}
+
+// DiscordNotifier Discord Webhook 推送，超过 2000 字的消息会被截断
+type DiscordNotifier struct {
+	WebhookURL string       `json:"webhookUrl"`         // Webhook 地址
+	Username   string       `json:"username,omitempty"` // 覆盖 Webhook 默认的显示名称
+	Client     *http.Client `json:"-"`                  // 为空时使用默认客户端
+}
+
+func (n *DiscordNotifier) Send(ctx context.Context, msg Message) error {
+	content := []rune(msg.Text())
+	if len(content) > discordMaxContent {
+		content = content[:discordMaxContent]
+	}
+	payload := map[string]string{"content": string(content)}
+	if n.Username != "" {
+		payload["username"] = n.Username
 	}
+	_, err := postJSON(ctx, n.Client, n.WebhookURL, payload, nil)
+	return err
+}
+
+// WebhookNotifier 通用 Webhook 推送，将 Message 以 JSON 格式 POST 到指定地址
+type WebhookNotifier struct {
+	URL     string            `json:"url"`               // 接收地址
+	Headers map[string]string `json:"headers,omitempty"` // 额外的请求头，如鉴权 token
+	Client  *http.Client      `json:"-"`                 // 为空时使用默认客户端
+}
+
+func (n *WebhookNotifier) Send(ctx context.Context, msg Message) error {
+	_, err := postJSON(ctx, n.Client, n.URL, msg, n.Headers)
+	return err
+}
 
-	resp, err := http.Post(url, "application/json", bytes.NewBuffer(jsonData))
+// EmailNotifier SMTP 邮件推送，465 端口使用 SSL，其它端口在服务器支持时使用 STARTTLS
+type EmailNotifier struct {
+	Host     string   `json:"host"`               // SMTP 服务器地址
+	Port     int      `json:"port"`               // SMTP 端口，默认 465
+	Username string   `json:"username,omitempty"` // 登录用户名，为空时不认证
+	Password string   `json:"password,omitempty"` // 登录密码或授权码
+	From     string   `json:"from"`               // 发件人，为空时使用 Username
+	To       []string `json:"to"`                 // 收件人
+}
+
+func (n *EmailNotifier) Send(ctx context.Context, msg Message) error {
+	if len(n.To) == 0 {
+		return fmt.Errorf("收件人不能为空")
+	}
+	port := n.Port
+	if port == 0 {
+		port = 465
+	}
+	from := n.From
+	if from == "" {
+		from = n.Username
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultNotifyTimeout)
+	defer cancel()
+	addr := net.JoinHostPort(n.Host, strconv.Itoa(port))
+	var conn net.Conn
+	var err error
+	if port == 465 {
+		dialer := &tls.Dialer{Config: &tls.Config{ServerName: n.Host}}
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	}
 	if err != nil {
-		return fmt.Errorf("failed to send dingding request: %v", err)
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
 	}
-	defer resp.Body.Close()
-	body, _ := io.ReadAll(resp.Body)
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("dingding API returned status %d: %s", resp.StatusCode, string(body))
+	client, err := smtp.NewClient(conn, n.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+	if ok, _ := client.Extension("STARTTLS"); ok && port != 465 {
+		if err := client.StartTLS(&tls.Config{ServerName: n.Host}); err != nil {
+			return err
+		}
+	}
+	if n.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", n.Username, n.Password, n.Host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+	for _, to := range n.To {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
 	}
 
-	fmt.Println(string(body))
+	subject := msg.Title
+	if msg.Level != "" && msg.Level != NotifyInfo {
+		subject = "[" + strings.ToUpper(string(msg.Level)) + "] " + subject
+	}
+	body := msg.Body
+	if len(msg.Tags) > 0 {
+		body += "\r\n\r\n#" + strings.Join(msg.Tags, " #")
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-Version: 1.0\r\nContent-Type: text/plain; charset=UTF-8\r\nContent-Transfer-Encoding: base64\r\n\r\n%s\r\n",
+		from, strings.Join(n.To, ", "), mime.BEncoding.Encode("UTF-8", subject), wrapBase64([]byte(body)))
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
 
-	return nil
+// wrapBase64 按 RFC 2045 每 76 个字符换行的 base64 编码
+func wrapBase64(data []byte) string {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	var b strings.Builder
+	for len(encoded) > 76 {
+		b.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	b.WriteString(encoded)
+	return b.String()
 }
@@ -0,0 +1,120 @@
+package galatvtr
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RedemptionStatus 赎回结果状态
+type RedemptionStatus string
+
+const (
+	RedemptionSkipped  RedemptionStatus = "skipped"  // 理财中没有可赎回的余额
+	RedemptionRedeemed RedemptionStatus = "redeemed" // 赎回已全部到账
+	RedemptionPartial  RedemptionStatus = "partial"  // 超时前只到账了一部分
+	RedemptionTimedOut RedemptionStatus = "timedout" // 超时前没有任何到账
+)
+
+const (
+	defaultRedemptionTimeout      = 30 * time.Second
+	defaultRedemptionPollInterval = 500 * time.Millisecond
+	// 到账数量与申请数量的容差，赎回数量经过精度截断，到账数量可能略有差异
+	redemptionArrivalTolerance = 1e-8
+)
+
+// RedemptionOptions 赎回等待策略
+type RedemptionOptions struct {
+	Timeout         time.Duration      // 等待到账的最长时间，默认 30 秒
+	PollInterval    time.Duration      // 轮询间隔，默认 500 毫秒
+	MaxPollInterval time.Duration      // 轮询间隔上限，大于 PollInterval 时每次轮询间隔翻倍直到该上限
+	BalanceUpdates  <-chan float64     // 可选，资金账户可用余额推送（如 WebSocket 余额频道），设置后不再轮询
+	TransferPartial bool               // 部分到账或超时时是否仍将已到账的余额划转到交易账户
+	OnPoll          func(int, float64) // 可选，每次获取到余额时回调，参数为次数和当前余额
+}
+
+// RedemptionResult 赎回结果
+type RedemptionResult struct {
+	Ccy         string           // 币种
+	Status      RedemptionStatus // 赎回结果状态
+	Requested   float64          // 申请赎回数量
+	Arrived     float64          // 已到账数量
+	Balance     float64          // 等待结束时资金账户可用余额
+	Transferred float64          // 划转到交易账户的数量
+	Elapsed     time.Duration    // 等待到账耗时
+}
+
+// incomplete 部分到账或超时未到账时返回错误，已到账的部分仍按 TransferPartial 划转
+func (r *RedemptionResult) incomplete() error {
+	if r == nil || (r.Status != RedemptionPartial && r.Status != RedemptionTimedOut) {
+		return nil
+	}
+	return fmt.Errorf("%s 赎回未全部到账: 状态 %s，申请 %.8f，到账 %.8f，已划转 %.8f",
+		r.Ccy, r.Status, r.Requested, r.Arrived, r.Transferred)
+}
+
+func (o RedemptionOptions) withDefaults() RedemptionOptions {
+	if o.Timeout <= 0 {
+		o.Timeout = defaultRedemptionTimeout
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = defaultRedemptionPollInterval
+	}
+	if o.MaxPollInterval < o.PollInterval {
+		o.MaxPollInterval = o.PollInterval
+	}
+	return o
+}
+
+// waitForRedemption 等待资金账户余额从 baseline 增加 requested，
+// 优先使用 BalanceUpdates 推送，否则按 PollInterval 调用 query 轮询，直到全部到账或超时
+func waitForRedemption(ctx context.Context, baseline, requested float64, opts RedemptionOptions, query func() (float64, error)) (RedemptionStatus, float64, error) {
+	opts = opts.withDefaults()
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	balance := baseline
+	interval := opts.PollInterval
+	for i := 1; ; i++ {
+		if opts.BalanceUpdates != nil {
+			select {
+			case <-ctx.Done():
+				return redemptionTimeoutStatus(baseline, balance), balance, nil
+			case value, ok := <-opts.BalanceUpdates:
+				if !ok {
+					return redemptionTimeoutStatus(baseline, balance), balance, fmt.Errorf("余额推送通道已关闭")
+				}
+				balance = value
+			}
+		} else {
+			select {
+			case <-ctx.Done():
+				return redemptionTimeoutStatus(baseline, balance), balance, nil
+			case <-time.After(interval):
+			}
+			value, err := query()
+			if err != nil {
+				fmt.Printf("[Redemption] 第%d次查询资金账户余额失败: %v\n", i, err)
+				continue
+			}
+			balance = value
+			if interval *= 2; interval > opts.MaxPollInterval {
+				interval = opts.MaxPollInterval
+			}
+		}
+
+		if opts.OnPoll != nil {
+			opts.OnPoll(i, balance)
+		}
+		if balance-baseline >= requested-redemptionArrivalTolerance {
+			return RedemptionRedeemed, balance, nil
+		}
+	}
+}
+
+func redemptionTimeoutStatus(baseline, balance float64) RedemptionStatus {
+	if balance > baseline {
+		return RedemptionPartial
+	}
+	return RedemptionTimedOut
+}
@@ -0,0 +1,489 @@
+package galatvtr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// TrailMode 止损跟踪方式
+type TrailMode string
+
+const (
+	TrailNone    TrailMode = ""        // 不跟踪，只使用保本
+	TrailPercent TrailMode = "percent" // 按最优价格回撤百分比跟踪
+	TrailAtr     TrailMode = "atr"     // 按最优价格回撤 ATR 倍数跟踪
+)
+
+const (
+	defaultStopManagerInterval = 5 * time.Second
+	defaultAtrPeriod           = 14
+	defaultAtrInterval         = "1H"
+)
+
+// ManagedStop 客户端管理的止损单，状态会持久化到 StopManager 的状态文件中
+type ManagedStop struct {
+	Id        string // 唯一标识，为空时自动生成
+	InstId    string // 产品ID，按交易所格式
+	Direction string // 持仓方向 long/short，决定止损单方向和跟踪方向
+	PosSide   string // 交易所持仓方向参数，OKX 开平仓模式下为 long/short，Gate 双向持仓时为 long/short
+	TdMode    string // OKX 交易模式 cash/isolated/cross
+	Sz        string // 止损数量，OKX 按下单数量，Gate 合约为张数
+
+	EntryPx float64 // 开仓价格
+	StopPx  float64 // 当前止损触发价
+	BestPx  float64 // 开仓后的最优价格，多仓为最高价，空仓为最低价
+
+	Mode         TrailMode // 跟踪方式
+	TrailPercent float64   // 回撤百分比，0.02 表示 2%
+	AtrMultiple  float64   // ATR 倍数
+	AtrInterval  string    // 计算 ATR 的 K 线周期，默认 1H
+	AtrPeriod    int       // ATR 周期，默认 14
+	Atr          float64   // 最近一次计算的 ATR
+	AtrUpdatedAt time.Time // 最近一次计算 ATR 的时间
+
+	BreakEvenTriggerPx float64 // 价格到达该值（如第一档止盈价）后将止损移动到保本价，0 表示不启用
+	BreakEvenOffset    float64 // 保本价相对开仓价格的偏移比例，用于覆盖手续费，0.001 表示 0.1%
+	BreakEvenDone      bool    // 是否已移动到保本价
+
+	MinMovePercent float64 // 止损价变化小于该比例时不修改，避免频繁改单
+	TickSz         float64 // 价格精度，设置后多仓止损价向下、空仓止损价向上取整
+
+	OrderId   string    // 交易所止损单ID
+	Active    bool      // 是否仍在管理中，止损单触发或撤销后为 false
+	UpdatedAt time.Time // 最近一次更新时间
+}
+
+// StopExecutor 止损单在具体交易所上的执行方式
+type StopExecutor interface {
+	// LastPrice 获取最新成交价
+	LastPrice(instId string) (float64, error)
+	// Candles 获取最近 limit 根 K 线，按时间升序
+	Candles(instId, interval string, limit int) ([]Candle, error)
+	// PlaceStop 按 stop.StopPx 下止损单，返回止损单ID
+	PlaceStop(stop ManagedStop) (string, error)
+	// MoveStop 将止损单的触发价修改为 stopPx，返回修改后的止损单ID（撤单重下时会变化）
+	MoveStop(stop ManagedStop, stopPx float64) (string, error)
+	// CancelStop 撤销止损单
+	CancelStop(stop ManagedStop) error
+	// StopActive 查询止损单是否仍未触发
+	StopActive(stop ManagedStop) (bool, error)
+}
+
+// StopManager 根据实时价格逐步上移（多仓）或下移（空仓）止损单，支持百分比跟踪、ATR 跟踪和保本。
+// 每次变化后将全部止损状态写入 path，重启后通过 NewStopManager 恢复
+type StopManager struct {
+	Interval time.Duration // Run 的轮询间隔，默认 5 秒
+
+	executor StopExecutor
+	path     string
+	mu       sync.Mutex
+	stops    map[string]*ManagedStop
+	busy     map[string]bool // 正在调用交易所接口的止损，交易所请求不持有 mu
+	idle     *sync.Cond      // busy 中的止损处理完成时通知
+}
+
+// NewStopManager 创建止损管理器，path 存在时从中恢复止损状态
+func NewStopManager(executor StopExecutor, path string) (*StopManager, error) {
+	m := &StopManager{
+		Interval: defaultStopManagerInterval,
+		executor: executor,
+		path:     path,
+		stops:    map[string]*ManagedStop{},
+		busy:     map[string]bool{},
+	}
+	m.idle = sync.NewCond(&m.mu)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var stops []*ManagedStop
+	if err := json.Unmarshal(data, &stops); err != nil {
+		return nil, fmt.Errorf("解析止损状态文件失败: %v", err)
+	}
+	for _, stop := range stops {
+		m.stops[stop.Id] = stop
+	}
+	return m, nil
+}
+
+// Add 添加止损，OrderId 为空时按 StopPx 下止损单
+func (m *StopManager) Add(stop ManagedStop) (*ManagedStop, error) {
+	if stop.Direction != "long" && stop.Direction != "short" {
+		return nil, fmt.Errorf("不支持的持仓方向: %s", stop.Direction)
+	}
+	if stop.StopPx <= 0 || stop.EntryPx <= 0 {
+		return nil, fmt.Errorf("开仓价格和止损价格必须大于 0")
+	}
+	if stop.Direction == "long" && stop.StopPx >= stop.EntryPx || stop.Direction == "short" && stop.StopPx <= stop.EntryPx {
+		return nil, fmt.Errorf("止损价格 %v 与开仓价格 %v 方向不符（%s）", stop.StopPx, stop.EntryPx, stop.Direction)
+	}
+	switch stop.Mode {
+	case TrailNone:
+	case TrailPercent:
+		if stop.TrailPercent <= 0 || stop.TrailPercent >= 1 {
+			return nil, fmt.Errorf("回撤百分比必须在 0 和 1 之间: %v", stop.TrailPercent)
+		}
+	case TrailAtr:
+		if stop.AtrMultiple <= 0 {
+			return nil, fmt.Errorf("ATR 倍数必须大于 0: %v", stop.AtrMultiple)
+		}
+	default:
+		return nil, fmt.Errorf("不支持的跟踪方式: %s", stop.Mode)
+	}
+
+	if stop.Id == "" {
+		stop.Id = fmt.Sprintf("%s-%s-%d", stop.InstId, stop.Direction, time.Now().UnixMilli())
+	}
+	if stop.BestPx == 0 {
+		stop.BestPx = stop.EntryPx
+	}
+	if stop.OrderId == "" {
+		orderId, err := m.executor.PlaceStop(stop)
+		if err != nil {
+			return nil, err
+		}
+		stop.OrderId = orderId
+	}
+	stop.Active = true
+	stop.UpdatedAt = time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stops[stop.Id] = &stop
+	return &stop, m.save()
+}
+
+// Remove 撤销止损单并停止管理，止损正在更新时等待更新完成
+func (m *StopManager) Remove(id string) error {
+	m.mu.Lock()
+	for m.busy[id] {
+		m.idle.Wait()
+	}
+	stop, ok := m.stops[id]
+	if !ok {
+		m.mu.Unlock()
+		return fmt.Errorf("止损不存在: %s", id)
+	}
+	snapshot := *stop
+	m.busy[id] = true
+	m.mu.Unlock()
+
+	var err error
+	if snapshot.Active {
+		err = m.executor.CancelStop(snapshot)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.release(id)
+	if err != nil {
+		return err
+	}
+	delete(m.stops, id)
+	return m.save()
+}
+
+// release 结束对止损的交易所操作，调用方需持有 mu
+func (m *StopManager) release(id string) {
+	delete(m.busy, id)
+	m.idle.Broadcast()
+}
+
+// Stops 返回全部止损的当前状态，按 Id 排序
+func (m *StopManager) Stops() []ManagedStop {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := make([]ManagedStop, 0, len(m.stops))
+	for _, stop := range m.stops {
+		result = append(result, *stop)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Id < result[j].Id })
+	return result
+}
+
+// OnPrice 用最新价格更新 instId 的全部止损，可用于接入 WebSocket 行情推送。
+// 交易所请求在锁外执行，正在更新的止损跳过本次价格
+func (m *StopManager) OnPrice(instId string, px float64) error {
+	m.mu.Lock()
+	var stops []ManagedStop
+	for _, stop := range m.stops {
+		if !stop.Active || stop.InstId != instId || m.busy[stop.Id] {
+			continue
+		}
+		m.busy[stop.Id] = true
+		stops = append(stops, *stop)
+	}
+	m.mu.Unlock()
+
+	changed := false
+	var errs []error
+	for i := range stops {
+		stop := &stops[i]
+		moved, err := m.update(stop, px)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", stop.Id, err))
+		}
+
+		m.mu.Lock()
+		// 只写回 update 修改的字段，ATR 和 Active 可能已被其它流程更新
+		if current, ok := m.stops[stop.Id]; ok && moved {
+			current.BestPx = stop.BestPx
+			current.OrderId = stop.OrderId
+			current.StopPx = stop.StopPx
+			current.BreakEvenDone = stop.BreakEvenDone
+			current.UpdatedAt = stop.UpdatedAt
+			changed = true
+		}
+		m.release(stop.Id)
+		m.mu.Unlock()
+	}
+	if changed {
+		m.mu.Lock()
+		if err := m.save(); err != nil {
+			errs = append(errs, err)
+		}
+		m.mu.Unlock()
+	}
+	return errors.Join(errs...)
+}
+
+// Run 按 Interval 轮询最新价格并更新止损，直到 ctx 结束。已触发或已撤销的止损单会被标记为不再管理
+func (m *StopManager) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.Interval)
+	defer ticker.Stop()
+	for {
+		m.poll()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *StopManager) poll() {
+	prices := map[string]float64{}
+	for _, stop := range m.Stops() {
+		// 正在移动的止损跳过本轮，旧订单撤销后查询状态会被误判为已触发
+		if !stop.Active || m.isBusy(stop.Id) {
+			continue
+		}
+		active, err := m.executor.StopActive(stop)
+		if err != nil {
+			fmt.Printf("[StopManager] %s 查询止损单状态失败: %v\n", stop.Id, err)
+			continue
+		}
+		if !active {
+			m.deactivate(stop)
+			continue
+		}
+		if stop.Mode == TrailAtr {
+			m.refreshAtr(stop)
+		}
+		if _, ok := prices[stop.InstId]; !ok {
+			px, err := m.executor.LastPrice(stop.InstId)
+			if err != nil {
+				fmt.Printf("[StopManager] %s 获取最新价格失败: %v\n", stop.InstId, err)
+				continue
+			}
+			prices[stop.InstId] = px
+		}
+	}
+	for instId, px := range prices {
+		if err := m.OnPrice(instId, px); err != nil {
+			fmt.Printf("[StopManager] %s 更新止损失败: %v\n", instId, err)
+		}
+	}
+}
+
+func (m *StopManager) isBusy(id string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.busy[id]
+}
+
+// deactivate 停止管理已触发或已撤销的止损，查询期间止损单已被替换时不处理
+func (m *StopManager) deactivate(snapshot ManagedStop) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if stop, ok := m.stops[snapshot.Id]; ok && !m.busy[snapshot.Id] && stop.OrderId == snapshot.OrderId {
+		fmt.Printf("[StopManager] %s 止损单 %s 已触发或已撤销，停止管理\n", stop.Id, stop.OrderId)
+		stop.Active = false
+		stop.UpdatedAt = time.Now()
+		if err := m.save(); err != nil {
+			fmt.Printf("[StopManager] 保存止损状态失败: %v\n", err)
+		}
+	}
+}
+
+// refreshAtr 每个 K 线周期最多重新计算一次 ATR
+func (m *StopManager) refreshAtr(stop ManagedStop) {
+	interval := stop.AtrInterval
+	if interval == "" {
+		interval = defaultAtrInterval
+	}
+	period := stop.AtrPeriod
+	if period <= 0 {
+		period = defaultAtrPeriod
+	}
+	if refresh, err := intervalDuration(interval); err == nil && time.Since(stop.AtrUpdatedAt) < refresh {
+		return
+	}
+	candles, err := m.executor.Candles(stop.InstId, interval, period+1)
+	if err != nil {
+		fmt.Printf("[StopManager] %s 获取K线失败: %v\n", stop.InstId, err)
+		return
+	}
+	atr := CalcAtr(candles, period)
+	if atr <= 0 {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if current, ok := m.stops[stop.Id]; ok {
+		current.Atr = atr
+		current.AtrUpdatedAt = time.Now()
+	}
+}
+
+// update 根据最新价格计算新的止损价，需要时修改止损单，返回是否有状态变化
+func (m *StopManager) update(stop *ManagedStop, px float64) (bool, error) {
+	long := stop.Direction == "long"
+	changed := false
+	if long && px > stop.BestPx || !long && px < stop.BestPx {
+		stop.BestPx = px
+		changed = true
+	}
+
+	target, breakEven := nextStopPx(*stop)
+	if stop.TickSz > 0 {
+		if long {
+			target = math.Floor(target/stop.TickSz) * stop.TickSz
+		} else {
+			target = math.Ceil(target/stop.TickSz) * stop.TickSz
+		}
+	}
+	if target == stop.StopPx || long && target < stop.StopPx || !long && target > stop.StopPx {
+		return changed, nil
+	}
+	// 新止损价已越过最新价格时下单会立即触发，等待下一次价格更新
+	if long && target >= px || !long && target <= px {
+		return changed, nil
+	}
+	if !breakEven && math.Abs(target-stop.StopPx)/stop.StopPx < stop.MinMovePercent {
+		return changed, nil
+	}
+
+	orderId, err := m.executor.MoveStop(*stop, target)
+	if err != nil {
+		return changed, err
+	}
+	fmt.Printf("[StopManager] %s 止损 %v -> %v，最优价格 %v\n", stop.Id, stop.StopPx, target, stop.BestPx)
+	stop.OrderId = orderId
+	stop.StopPx = target
+	stop.BreakEvenDone = stop.BreakEvenDone || breakEven
+	stop.UpdatedAt = time.Now()
+	return true, nil
+}
+
+// nextStopPx 计算跟踪和保本后的止损价，止损只会向有利方向移动；第二个返回值表示本次是否触发了保本
+func nextStopPx(stop ManagedStop) (float64, bool) {
+	long := stop.Direction == "long"
+	better := func(a, b float64) float64 {
+		if long {
+			return math.Max(a, b)
+		}
+		return math.Min(a, b)
+	}
+	sign := 1.0
+	if !long {
+		sign = -1
+	}
+
+	target := stop.StopPx
+	switch stop.Mode {
+	case TrailPercent:
+		target = better(target, stop.BestPx*(1-sign*stop.TrailPercent))
+	case TrailAtr:
+		if stop.Atr > 0 {
+			target = better(target, stop.BestPx-sign*stop.Atr*stop.AtrMultiple)
+		}
+	}
+
+	breakEven := false
+	if !stop.BreakEvenDone && stop.BreakEvenTriggerPx > 0 &&
+		(long && stop.BestPx >= stop.BreakEvenTriggerPx || !long && stop.BestPx <= stop.BreakEvenTriggerPx) {
+		breakEvenPx := stop.EntryPx * (1 + sign*stop.BreakEvenOffset)
+		if better(target, breakEvenPx) != target {
+			target = breakEvenPx
+		}
+		breakEven = true
+	}
+	return target, breakEven
+}
+
+// save 将全部止损状态写入状态文件，先写临时文件再重命名，避免写入中断导致文件损坏
+func (m *StopManager) save() error {
+	stops := make([]*ManagedStop, 0, len(m.stops))
+	for _, stop := range m.stops {
+		stops = append(stops, stop)
+	}
+	sort.Slice(stops, func(i, j int) bool { return stops[i].Id < stops[j].Id })
+	data, err := json.MarshalIndent(stops, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := m.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, m.path)
+}
+
+// CalcAtr 计算最近 period 根 K 线的平均真实波幅（简单平均），K 线须按时间升序，数量不足时返回 0
+func CalcAtr(candles []Candle, period int) float64 {
+	if period <= 0 || len(candles) < period+1 {
+		return 0
+	}
+	var sum float64
+	for i := len(candles) - period; i < len(candles); i++ {
+		prevClose := candles[i-1].Close
+		tr := math.Max(candles[i].High-candles[i].Low,
+			math.Max(math.Abs(candles[i].High-prevClose), math.Abs(candles[i].Low-prevClose)))
+		sum += tr
+	}
+	return sum / float64(period)
+}
+
+// intervalDuration 将 K 线周期（如 1m、15m、1H、4h、1D）转换为时长
+func intervalDuration(interval string) (time.Duration, error) {
+	var n int
+	var unit string
+	if _, err := fmt.Sscanf(interval, "%d%s", &n, &unit); err != nil || n <= 0 {
+		return 0, fmt.Errorf("不支持的K线周期: %s", interval)
+	}
+	switch unit {
+	case "s":
+		return time.Duration(n) * time.Second, nil
+	case "m":
+		return time.Duration(n) * time.Minute, nil
+	case "H", "h":
+		return time.Duration(n) * time.Hour, nil
+	case "D", "d":
+		return time.Duration(n) * 24 * time.Hour, nil
+	case "W", "w":
+		return time.Duration(n) * 7 * 24 * time.Hour, nil
+	default:
+		return 0, fmt.Errorf("不支持的K线周期: %s", interval)
+	}
+}
@@ -3,6 +3,7 @@ package galatvtr
 import (
 	"fmt"
 	"math"
+	"net/url"
 	"strings"
 )
 
@@ -60,3 +61,37 @@ func ConvertTvTrickerToSingleCoinName(ticker string) (string, error) {
 
 	return "", fmt.Errorf("无法识别的交易对格式: %s", ticker)
 }
+
+// normalizeGateioInstId 统一交易对格式，已是 Gate 格式（如 BTC_USDT）的直接返回，否则按 TradingView 格式转换
+func normalizeGateioInstId(ticker string) (string, error) {
+	if strings.Contains(ticker, "_") {
+		return strings.ToUpper(ticker), nil
+	}
+	return convertTradingViewTickerToGateioInstId(ticker)
+}
+
+// okxQuery 拼接查询参数，params 为 key、value 交替排列，value 为空的参数会被忽略
+func okxQuery(endpoint string, params ...string) string {
+	separator := "?"
+	for i := 0; i+1 < len(params); i += 2 {
+		if params[i+1] == "" {
+			continue
+		}
+		endpoint += separator + params[i] + "=" + url.QueryEscape(params[i+1])
+		separator = "&"
+	}
+	return endpoint
+}
+
+// zhuanbiCeilFloat 按 zhuanbiFormatFloat 的精度向上取整，用于保证划转数量不少于所需数量
+func zhuanbiCeilFloat(instId string, value float64) float64 {
+	floor := zhuanbiFormatFloat(instId, value)
+	if floor >= value {
+		return floor
+	}
+	step := 0.01
+	if instId == "BTC" || instId == "ETH" || instId == "OKB" {
+		step = 0.0001
+	}
+	return zhuanbiFormatFloat(instId, floor+step+step/10)
+}